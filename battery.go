@@ -0,0 +1,231 @@
+package kontaktparser
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// VoltagePoint is a single point of battery discharge curve
+type VoltagePoint struct {
+	Millivolts uint16
+	Percent    float64
+}
+
+// VoltageCurve maps battery voltage to remaining capacity. Points have to be sorted by voltage, descending.
+type VoltageCurve []VoltagePoint
+
+// Percent returns remaining capacity for given voltage, interpolating linearly between curve points
+func (c VoltageCurve) Percent(millivolts uint16) float64 {
+	if len(c) == 0 {
+		return 0
+	}
+	if millivolts >= c[0].Millivolts {
+		return c[0].Percent
+	}
+	for i := 1; i < len(c); i++ {
+		upper, lower := c[i-1], c[i]
+		if millivolts >= lower.Millivolts {
+			ratio := float64(millivolts-lower.Millivolts) / float64(upper.Millivolts-lower.Millivolts)
+			return lower.Percent + ratio*(upper.Percent-lower.Percent)
+		}
+	}
+	return c[len(c)-1].Percent
+}
+
+var (
+	coinCellCurve = VoltageCurve{
+		{3000, 100}, {2900, 80}, {2800, 60}, {2700, 40}, {2600, 20}, {2500, 10}, {2200, 0},
+	}
+	aaCurve = VoltageCurve{
+		{3200, 100}, {3000, 80}, {2800, 50}, {2600, 20}, {2400, 5}, {2000, 0},
+	}
+	thionylChlorideCurve = VoltageCurve{
+		{3650, 100}, {3600, 80}, {3500, 50}, {3400, 20}, {3300, 10}, {3000, 0},
+	}
+	lithiumPolymerCurve = VoltageCurve{
+		{4200, 100}, {4000, 80}, {3800, 55}, {3700, 40}, {3600, 20}, {3500, 10}, {3300, 0},
+	}
+)
+
+// batteryCurves maps battery types to their discharge curves. Coin cell curve is used when battery is unknown.
+var batteryCurves = map[BatteryType]VoltageCurve{
+	UnknownBattery:      coinCellCurve,
+	BatteryCR2032:       coinCellCurve,
+	BatteryCR2477:       coinCellCurve,
+	BatteryAA:           aaCurve,
+	BatteryER34615:      thionylChlorideCurve,
+	BatteryRechargeable: lithiumPolymerCurve,
+}
+
+// VoltageToPercent converts battery voltage to remaining capacity using discharge curve of model's battery.
+// False is returned for models without battery.
+func VoltageToPercent(model DeviceModel, millivolts uint16) (float64, bool) {
+	battery := UnknownBattery
+	if info, ok := model.Info(); ok {
+		battery = info.Battery
+	}
+	curve, ok := batteryCurves[battery]
+	if !ok {
+		return 0, false
+	}
+	return curve.Percent(millivolts), true
+}
+
+// BatteryPercent extracts battery level in percent from parsed frame (Parser.Parsed).
+// Model is used to convert Eddystone TLM voltage. False is returned when frame doesn't carry battery level.
+func BatteryPercent(parsed interface{}, model DeviceModel) (float64, bool) {
+	switch frame := parsed.(type) {
+	case *KontaktPlainAdvertisement:
+		return float64(frame.BatteryLevel), true
+	case *KontaktShuffledAdvertisement:
+		return float64(frame.BatteryLevel), true
+	case *KontaktIOScanResponse:
+		if !frame.HasIdentifier {
+			return 0, false
+		}
+		return float64(frame.BatteryLevel), true
+	case *KontaktTelemetryAdvertisement:
+		for _, field := range frame.Fields {
+			switch field.PID {
+			case SystemHealth:
+				health := SystemHealthFieldParser{}
+				if err := health.Parse(field); err == nil {
+					return float64(health.BatteryLevel), true
+				}
+			case BatteryLevel:
+				battery := BatteryFieldParser{}
+				if err := battery.Parse(field); err == nil {
+					return float64(battery.BatteryLevel), true
+				}
+			}
+		}
+	case *EddystonePlainTLMPacket:
+		if !frame.HasBatteryVoltage() {
+			return 0, false
+		}
+		return VoltageToPercent(model, frame.BatteryVoltage)
+	}
+	return 0, false
+}
+
+// BatteryReading is a battery level reported by beacon at given time
+type BatteryReading struct {
+	Time    time.Time
+	Percent float64
+}
+
+// BatteryHealth keeps battery level history of a single beacon and estimates when battery
+// needs to be replaced. It's not safe for concurrent use.
+type BatteryHealth struct {
+	// Model is used to convert Eddystone TLM voltage, it's updated from Kontakt.io frames
+	Model DeviceModel
+	// MaxHistory limits number of kept readings, 0 means no limit
+	MaxHistory int
+	readings   []BatteryReading
+}
+
+// NewBatteryHealth creates BatteryHealth keeping at most maxHistory readings
+func NewBatteryHealth(model DeviceModel, maxHistory int) *BatteryHealth {
+	return &BatteryHealth{
+		Model:      model,
+		MaxHistory: maxHistory,
+	}
+}
+
+// Update records battery level from parsed frame received at given time.
+// It returns false when frame doesn't carry battery level.
+func (b *BatteryHealth) Update(received time.Time, parsed interface{}) bool {
+	switch frame := parsed.(type) {
+	case *KontaktPlainAdvertisement:
+		b.Model = frame.Model()
+	case *KontaktShuffledAdvertisement:
+		b.Model = frame.Model()
+	case *KontaktLocationAdvertisement:
+		b.Model = frame.Model()
+	}
+	percent, ok := BatteryPercent(parsed, b.Model)
+	if !ok {
+		return false
+	}
+	b.Add(BatteryReading{Time: received, Percent: percent})
+	return true
+}
+
+// Add records battery reading, keeping history sorted by time
+func (b *BatteryHealth) Add(reading BatteryReading) {
+	i := sort.Search(len(b.readings), func(i int) bool {
+		return b.readings[i].Time.After(reading.Time)
+	})
+	b.readings = append(b.readings, BatteryReading{})
+	copy(b.readings[i+1:], b.readings[i:])
+	b.readings[i] = reading
+	if b.MaxHistory > 0 && len(b.readings) > b.MaxHistory {
+		b.readings = b.readings[len(b.readings)-b.MaxHistory:]
+	}
+}
+
+// Latest returns the most recent reading
+func (b *BatteryHealth) Latest() (BatteryReading, bool) {
+	if len(b.readings) == 0 {
+		return BatteryReading{}, false
+	}
+	return b.readings[len(b.readings)-1], true
+}
+
+// History returns copy of recorded readings, oldest first
+func (b *BatteryHealth) History() []BatteryReading {
+	history := make([]BatteryReading, len(b.readings))
+	copy(history, b.readings)
+	return history
+}
+
+// DischargeRate returns battery level change in percent per day, estimated with linear regression
+// over the history. False is returned when history doesn't span any time.
+func (b *BatteryHealth) DischargeRate() (float64, bool) {
+	slope, _, ok := b.trend()
+	if !ok {
+		return 0, false
+	}
+	return slope * (24 * time.Hour).Seconds(), true
+}
+
+// ReplacementDate projects when battery level drops to threshold percent based on discharge trend.
+// False is returned when there is not enough history, battery is not discharging or discharges so slowly
+// that the date is out of time.Duration range (about 292 years) from the oldest reading.
+func (b *BatteryHealth) ReplacementDate(threshold float64) (time.Time, bool) {
+	slope, intercept, ok := b.trend()
+	if !ok || slope >= 0 {
+		return time.Time{}, false
+	}
+	origin := b.readings[0].Time
+	seconds := (threshold - intercept) / slope
+	if math.Abs(seconds) >= float64(math.MaxInt64/time.Second) {
+		return time.Time{}, false
+	}
+	return origin.Add(time.Duration(seconds * float64(time.Second))), true
+}
+
+// trend fits percent = slope*t + intercept, where t is number of seconds since the oldest reading
+func (b *BatteryHealth) trend() (slope float64, intercept float64, ok bool) {
+	if len(b.readings) < 2 {
+		return 0, 0, false
+	}
+	origin := b.readings[0].Time
+	n := float64(len(b.readings))
+	var sumT, sumP, sumTT, sumTP float64
+	for _, reading := range b.readings {
+		t := reading.Time.Sub(origin).Seconds()
+		sumT += t
+		sumP += reading.Percent
+		sumTT += t * t
+		sumTP += t * reading.Percent
+	}
+	denominator := n*sumTT - sumT*sumT
+	if denominator == 0 {
+		return 0, 0, false
+	}
+	slope = (n*sumTP - sumT*sumP) / denominator
+	intercept = (sumP - slope*sumT) / n
+	return slope, intercept, true
+}
@@ -0,0 +1,123 @@
+package kontaktparser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVoltageCurvePercent(t *testing.T) {
+	assert.Equal(t, float64(100), coinCellCurve.Percent(3300))
+	assert.Equal(t, float64(80), coinCellCurve.Percent(2900))
+	assert.InDelta(t, float64(70), coinCellCurve.Percent(2850), 0.0001)
+	assert.Equal(t, float64(0), coinCellCurve.Percent(1800))
+}
+
+func TestVoltageToPercent(t *testing.T) {
+	percent, ok := VoltageToPercent(Badge, 4000)
+	assert.True(t, ok)
+	assert.Equal(t, float64(80), percent)
+
+	percent, ok = VoltageToPercent(UnknownModel, 2800)
+	assert.True(t, ok)
+	assert.Equal(t, float64(60), percent)
+
+	_, ok = VoltageToPercent(USBBeacon, 5000)
+	assert.False(t, ok)
+}
+
+func TestBatteryPercentFromFrames(t *testing.T) {
+	percent, ok := BatteryPercent(&KontaktPlainAdvertisement{BatteryLevel: 90}, UnknownModel)
+	assert.True(t, ok)
+	assert.Equal(t, float64(90), percent)
+
+	percent, ok = BatteryPercent(&KontaktIOScanResponse{BatteryLevel: 40, HasIdentifier: true}, UnknownModel)
+	assert.True(t, ok)
+	assert.Equal(t, float64(40), percent)
+
+	telemetry := &KontaktTelemetryAdvertisement{Fields: []KontaktTelemetryValue{
+		{PID: LightLevel, Value: []byte{0x10}},
+		{PID: SystemHealth, Value: []byte{0x00, 0x2F, 0x68, 0x59, 0x37}},
+	}}
+	percent, ok = BatteryPercent(telemetry, UnknownModel)
+	assert.True(t, ok)
+	assert.Equal(t, float64(55), percent)
+
+	percent, ok = BatteryPercent(&EddystonePlainTLMPacket{BatteryVoltage: 3500}, ToughBeacon)
+	assert.True(t, ok)
+	assert.Equal(t, float64(50), percent)
+}
+
+func TestBatteryPercentMissing(t *testing.T) {
+	_, ok := BatteryPercent(&KontaktIOScanResponse{HasName: true}, UnknownModel)
+	assert.False(t, ok)
+	_, ok = BatteryPercent(&EddystonePlainTLMPacket{BatteryVoltage: 0}, SmartBeacon)
+	assert.False(t, ok)
+	_, ok = BatteryPercent(&KontaktTelemetryAdvertisement{}, SmartBeacon)
+	assert.False(t, ok)
+	_, ok = BatteryPercent(&IBeaconAdvertisement{}, SmartBeacon)
+	assert.False(t, ok)
+}
+
+func TestBatteryHealthUpdatesModel(t *testing.T) {
+	health := NewBatteryHealth(UnknownModel, 0)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.True(t, health.Update(now, &KontaktPlainAdvertisement{DeviceModel: uint8(ToughBeacon), BatteryLevel: 99}))
+	assert.Equal(t, ToughBeacon, health.Model)
+	assert.True(t, health.Update(now.Add(time.Minute), &EddystonePlainTLMPacket{BatteryVoltage: 3600}))
+	assert.False(t, health.Update(now.Add(time.Minute), &IBeaconAdvertisement{}))
+
+	latest, ok := health.Latest()
+	assert.True(t, ok)
+	assert.Equal(t, float64(80), latest.Percent)
+	assert.Equal(t, 2, len(health.History()))
+}
+
+func TestBatteryHealthHistoryLimit(t *testing.T) {
+	health := NewBatteryHealth(SmartBeacon, 2)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	health.Add(BatteryReading{Time: now.Add(2 * time.Hour), Percent: 80})
+	health.Add(BatteryReading{Time: now, Percent: 100})
+	health.Add(BatteryReading{Time: now.Add(time.Hour), Percent: 90})
+
+	assert.Equal(t, []BatteryReading{
+		{Time: now.Add(time.Hour), Percent: 90},
+		{Time: now.Add(2 * time.Hour), Percent: 80},
+	}, health.History())
+}
+
+func TestBatteryHealthReplacementDate(t *testing.T) {
+	health := NewBatteryHealth(SmartBeacon, 0)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	health.Add(BatteryReading{Time: start, Percent: 100})
+	health.Add(BatteryReading{Time: start.Add(10 * day), Percent: 99})
+	health.Add(BatteryReading{Time: start.Add(20 * day), Percent: 98})
+
+	rate, ok := health.DischargeRate()
+	assert.True(t, ok)
+	assert.InDelta(t, -0.1, rate, 0.0001)
+
+	date, ok := health.ReplacementDate(10)
+	assert.True(t, ok)
+	assert.WithinDuration(t, start.Add(900*day), date, time.Second)
+}
+
+func TestBatteryHealthReplacementDateNotDischarging(t *testing.T) {
+	health := NewBatteryHealth(SmartBeacon, 0)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, ok := health.ReplacementDate(10)
+	assert.False(t, ok)
+
+	health.Add(BatteryReading{Time: start, Percent: 100})
+	health.Add(BatteryReading{Time: start.Add(time.Hour), Percent: 100})
+	_, ok = health.ReplacementDate(10)
+	assert.False(t, ok)
+
+	// loses 1% in over 2000 years, date doesn't fit time.Duration
+	health.Add(BatteryReading{Time: start.Add(2 * time.Hour), Percent: 100 - 1e-7})
+	_, ok = health.ReplacementDate(10)
+	assert.False(t, ok)
+}
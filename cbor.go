@@ -0,0 +1,43 @@
+package kontaktparser
+
+import (
+	"github.com/fxamacker/cbor/v2"
+)
+
+var cborEncoder cbor.EncMode
+
+func init() {
+	var err error
+	cborEncoder, err = cbor.EncOptions{ShortestFloat: cbor.ShortestFloat16}.EncMode()
+	if err != nil {
+		panic(err)
+	}
+}
+
+// EncodeCBOR encodes parsed frame (Parser.Parsed) as CBOR map with integer keys. Key 0 holds DetectedType,
+// other keys are described in README. Byte fields are encoded as byte strings.
+func EncodeCBOR(parsed interface{}) ([]byte, error) {
+	wire, err := toWire(parsed)
+	if err != nil {
+		return nil, err
+	}
+	return cborEncoder.Marshal(wire)
+}
+
+// DecodeCBOR decodes frame encoded with EncodeCBOR into the type Parser.Parsed would hold
+func DecodeCBOR(data []byte) (interface{}, error) {
+	envelope := struct {
+		Type DetectedType `cbor:"0,keyasint"`
+	}{}
+	if err := cbor.Unmarshal(data, &envelope); err != nil {
+		return nil, err
+	}
+	wire, err := emptyWire(envelope.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := cbor.Unmarshal(data, wire); err != nil {
+		return nil, err
+	}
+	return wire.frame(), nil
+}
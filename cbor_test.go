@@ -0,0 +1,55 @@
+package kontaktparser
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCBORRoundTrip(t *testing.T) {
+	for _, parser := range allFixtures(t) {
+		data, err := EncodeCBOR(parser.Parsed)
+		assert.Nil(t, err)
+
+		frame, err := DecodeCBOR(data)
+		assert.Nil(t, err, hex.EncodeToString(data))
+		assert.Equal(t, parser.Parsed, frame)
+	}
+}
+
+func TestCBORIsSmallerThanJSON(t *testing.T) {
+	for _, parser := range allFixtures(t) {
+		encoded, err := EncodeCBOR(parser.Parsed)
+		assert.Nil(t, err)
+		jsonEncoded, err := json.Marshal(parser.Parsed)
+		assert.Nil(t, err)
+		assert.True(t, len(encoded) < len(jsonEncoded)/2, parser.DetectedType.String())
+	}
+}
+
+func TestCBORKontaktPlain(t *testing.T) {
+	parser := parseFixture(t, frameFixtures[KontaktPlain], false)
+	data, err := EncodeCBOR(parser.Parsed)
+	assert.Nil(t, err)
+	// {0: 8, 1: 6, 2: 1, 3: 15, 4: 100, 5: 4, 6: "abcdef"}
+	assert.Equal(t, "a7000801060201030f04186405040666616263646566", hex.EncodeToString(data))
+}
+
+func TestCBORTelemetry(t *testing.T) {
+	parser := parseFixture(t, frameFixtures[KontaktTelemetry], false)
+	data, err := EncodeCBOR(parser.Parsed)
+	assert.Nil(t, err)
+	// {0: 10, 1: [[10, h'64'], [17, h'065ba0']]}
+	assert.Equal(t, "a2000a0182820a4164821143065ba0", hex.EncodeToString(data))
+}
+
+func TestDecodeCBORInvalidType(t *testing.T) {
+	// {0: 200}
+	_, err := DecodeCBOR([]byte{0xA1, 0x00, 0x18, 0xC8})
+	assert.Equal(t, ErrInvalidFrameType, err)
+
+	_, err = DecodeCBOR([]byte{0xFF})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,234 @@
+// Command kontakt-decode decodes BLE advertisements and scan responses given as hex.
+//
+// Packets are read from arguments or, when there are none, from standard input, one per line.
+// Advertisement or scan response is detected automatically unless -adv or -scan-response is used.
+// Exit code is 1 when any packet couldn't be decoded, wasn't recognised or carries telemetry field which
+// couldn't be decoded, and 2 on invalid usage.
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+// packetMode selects how packet is parsed
+type packetMode int
+
+const (
+	autoMode packetMode = iota
+	advertisementMode
+	scanResponseMode
+)
+
+// decoded is a result of decoding single packet
+type decoded struct {
+	Input        string                     `json:"input"`
+	ScanResponse bool                       `json:"scan_response"`
+	Type         kontaktparser.DetectedType `json:"type"`
+	Flags        byte                       `json:"flags"`
+	Frame        interface{}                `json:"frame,omitempty"`
+	Telemetry    []decodedTelemetry         `json:"telemetry,omitempty"`
+	Error        string                     `json:"error,omitempty"`
+}
+
+// decodedTelemetry is a Kontakt.io telemetry field decoded with its field parser, Value holds named values
+// the same as in exported frames
+type decodedTelemetry struct {
+	PID   kontaktparser.TelemetryPID `json:"pid"`
+	Value map[string]interface{}     `json:"value,omitempty"`
+	Error string                     `json:"error,omitempty"`
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("kontakt-decode", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	asJSON := flags.Bool("json", false, "print JSON lines instead of table")
+	advertisement := flags.Bool("adv", false, "parse packets as advertisements")
+	scanResponse := flags.Bool("scan-response", false, "parse packets as scan responses")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: kontakt-decode [-json] [-adv | -scan-response] [hex ...]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *advertisement && *scanResponse {
+		flags.Usage()
+		return 2
+	}
+	mode := autoMode
+	if *advertisement {
+		mode = advertisementMode
+	} else if *scanResponse {
+		mode = scanResponseMode
+	}
+
+	inputs := flags.Args()
+	if len(inputs) == 0 {
+		scanner := bufio.NewScanner(stdin)
+		for scanner.Scan() {
+			inputs = append(inputs, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	}
+
+	failed := false
+	encoder := json.NewEncoder(stdout)
+	for _, input := range inputs {
+		input = strings.TrimSpace(input)
+		if input == "" {
+			continue
+		}
+		result := decode(input, mode)
+		if result.failed() {
+			failed = true
+		}
+		if *asJSON {
+			if err := encoder.Encode(result); err != nil {
+				fmt.Fprintln(stderr, err)
+				return 1
+			}
+		} else {
+			printTable(stdout, result)
+		}
+	}
+	if failed {
+		return 1
+	}
+	return 0
+}
+
+// failed reports whether packet or any of its telemetry fields couldn't be decoded, or its type wasn't
+// recognised
+func (d decoded) failed() bool {
+	if d.Error != "" || d.Type == kontaktparser.Unknown {
+		return true
+	}
+	for _, field := range d.Telemetry {
+		if field.Error != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// decode parses packet hex, advertisement is tried first in auto mode
+func decode(input string, mode packetMode) decoded {
+	result := decoded{Input: input}
+	data, err := hex.DecodeString(strings.TrimPrefix(strings.Replace(input, " ", "", -1), "0x"))
+	if err != nil {
+		result.Error = "invalid hex: " + err.Error()
+		return result
+	}
+
+	parser := kontaktparser.New(data)
+	if mode == scanResponseMode {
+		err = parser.ParseScanResponse()
+		result.ScanResponse = true
+	} else {
+		err = parser.ParseAdvertisement()
+		if mode == autoMode && (err != nil || parser.DetectedType == kontaktparser.Unknown) {
+			scanResponse := kontaktparser.New(data)
+			if scanResponse.ParseScanResponse() == nil && scanResponse.DetectedType == kontaktparser.KontaktScanResponse {
+				parser, err = scanResponse, nil
+				result.ScanResponse = true
+			}
+		}
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Type = parser.DetectedType
+	result.Flags = parser.Flags
+	result.Frame = parser.Parsed
+	if telemetry, ok := parser.Parsed.(*kontaktparser.KontaktTelemetryAdvertisement); ok {
+		for _, field := range telemetry.Fields {
+			value := decodedTelemetry{PID: field.PID}
+			if fieldParser, err := kontaktparser.DecodeTelemetry(field); err != nil {
+				value.Error = err.Error()
+			} else if valuer, ok := fieldParser.(kontaktparser.FieldValuer); ok {
+				value.Value = valuer.Values()
+			}
+			result.Telemetry = append(result.Telemetry, value)
+		}
+	}
+	return result
+}
+
+func printTable(w io.Writer, result decoded) {
+	table := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(table, "input\t%s\n", result.Input)
+	if result.Error != "" {
+		fmt.Fprintf(table, "error\t%s\n", result.Error)
+	} else {
+		fmt.Fprintf(table, "scan response\t%v\n", result.ScanResponse)
+		fmt.Fprintf(table, "type\t%s\n", result.Type)
+		fmt.Fprintf(table, "flags\t0x%02x\n", result.Flags)
+		if result.Frame != nil {
+			printFields(table, "", reflect.ValueOf(result.Frame))
+		}
+		for _, field := range result.Telemetry {
+			prefix := "telemetry." + field.PID.String()
+			if field.Error != "" {
+				fmt.Fprintf(table, "%s\terror: %s\n", prefix, field.Error)
+				continue
+			}
+			names := make([]string, 0, len(field.Value))
+			for name := range field.Value {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				fmt.Fprintf(table, "%s.%s\t%v\n", prefix, name, field.Value[name])
+			}
+		}
+	}
+	fmt.Fprintln(table)
+	table.Flush()
+}
+
+// printFields prints exported struct fields, nested structs are prefixed with their field name
+func printFields(w io.Writer, prefix string, value reflect.Value) {
+	for value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	typ := value.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		fieldValue := value.Field(i)
+		switch {
+		case field.Name == "Fields" && typ == reflect.TypeOf(kontaktparser.KontaktTelemetryAdvertisement{}):
+			// telemetry fields are printed decoded
+			continue
+		case fieldValue.Kind() == reflect.Slice && fieldValue.Type().Elem().Kind() == reflect.Uint8:
+			fmt.Fprintf(w, "%s%s\t%x\n", prefix, field.Name, fieldValue.Bytes())
+		case fieldValue.Kind() == reflect.Struct && fieldValue.Type().PkgPath() == typ.PkgPath():
+			printFields(w, prefix+field.Name+".", fieldValue)
+		default:
+			fmt.Fprintf(w, "%s%s\t%v\n", prefix, field.Name, fieldValue.Interface())
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	plainHex        = "0201060F166AFE0206010F6404616263646566"
+	telemetryHex    = "0201060C166AFE03020A640411065BA0"
+	scanResponseHex = "080961626364656667020A040A160DD061626364040264"
+)
+
+func runDecode(args []string, stdin string) (int, string, string) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	code := run(args, strings.NewReader(stdin), stdout, stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestDecodeTable(t *testing.T) {
+	code, stdout, _ := runDecode([]string{plainHex, telemetryHex}, "")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "type           kontakt_plain\n")
+	assert.Contains(t, stdout, "UniqueID       abcdef\n")
+	assert.Contains(t, stdout, "telemetry.light_level.light_level         100\n")
+	assert.Contains(t, stdout, "telemetry.click_info.seconds_since_click  41051\n")
+}
+
+func TestDecodeJSONFromStdin(t *testing.T) {
+	code, stdout, _ := runDecode([]string{"-json"}, plainHex+"\n\n"+scanResponseHex+"\n")
+	assert.Equal(t, 0, code)
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Len(t, lines, 2)
+
+	plain := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal([]byte(lines[0]), &plain))
+	assert.Equal(t, "kontakt_plain", plain["type"])
+	assert.Equal(t, false, plain["scan_response"])
+	assert.Equal(t, "abcdef", plain["frame"].(map[string]interface{})["unique_id"])
+
+	scanResponse := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal([]byte(lines[1]), &scanResponse))
+	assert.Equal(t, "kontakt_scan_response", scanResponse["type"])
+	assert.Equal(t, true, scanResponse["scan_response"])
+}
+
+func TestDecodeTelemetryJSON(t *testing.T) {
+	code, stdout, _ := runDecode([]string{"-json", telemetryHex}, "")
+	assert.Equal(t, 0, code)
+
+	result := struct {
+		Telemetry []struct {
+			PID   string                 `json:"pid"`
+			Value map[string]interface{} `json:"value"`
+		} `json:"telemetry"`
+	}{}
+	assert.Nil(t, json.Unmarshal([]byte(stdout), &result))
+	assert.Len(t, result.Telemetry, 2)
+	assert.Equal(t, "light_level", result.Telemetry[0].PID)
+	assert.Equal(t, map[string]interface{}{"light_level": float64(100)}, result.Telemetry[0].Value)
+	assert.Equal(t, "click_info", result.Telemetry[1].PID)
+	assert.Equal(t, map[string]interface{}{"click_id": float64(6), "seconds_since_click": float64(41051)}, result.Telemetry[1].Value)
+}
+
+func TestDecodeForcedMode(t *testing.T) {
+	code, stdout, _ := runDecode([]string{"-adv", scanResponseHex}, "")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout, "type           unknown\n")
+
+	code, stdout, _ = runDecode([]string{"-scan-response", scanResponseHex}, "")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "type                            kontakt_scan_response\n")
+}
+
+func TestDecodeErrors(t *testing.T) {
+	code, stdout, _ := runDecode([]string{plainHex, "zz"}, "")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout, "error  invalid hex")
+
+	code, stdout, _ = runDecode([]string{"-json", "0201060F166AFE02"}, "")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout, `"error":"EOF"`)
+
+	// packet which isn't recognised
+	code, stdout, _ = runDecode([]string{plainHex, "0303AAFE"}, "")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout, "type           unknown\n")
+
+	// telemetry field which couldn't be decoded
+	code, stdout, _ = runDecode([]string{"02010608166AFE0303122A2A"}, "")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout, "telemetry.humidity  error: invalid telemetry field pid\n")
+
+	code, _, stderr := runDecode([]string{"-adv", "-scan-response", plainHex}, "")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "usage")
+
+	code, _, _ = runDecode([]string{"-unknown"}, "")
+	assert.Equal(t, 2, code)
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+	"github.com/sz33psz/kontakt-beacon-parser/gateway"
+)
+
+var (
+	ErrInvalidScan   = errors.New("invalid scan payload")
+	ErrUnknownBeacon = errors.New("frame has neither beacon identity nor MAC address")
+)
+
+// beaconMessage is an enriched frame republished per beacon
+type beaconMessage struct {
+	ID        string                     `json:"id"`
+	Receiver  string                     `json:"receiver,omitempty"`
+	MAC       string                     `json:"mac,omitempty"`
+	RSSI      int8                       `json:"rssi"`
+	Received  time.Time                  `json:"received"`
+	Type      kontaktparser.DetectedType `json:"type"`
+	Model     string                     `json:"model,omitempty"`
+	Battery   *float64                   `json:"battery,omitempty"`
+	Distance  *float64                   `json:"distance,omitempty"`
+	Proximity string                     `json:"proximity,omitempty"`
+	Frame     json.RawMessage            `json:"frame"`
+}
+
+// Bridge decodes raw scans and republishes them as enriched JSON per beacon
+type Bridge struct {
+	// TopicTemplate is a topic of republished frames, "{id}", "{type}" and "{receiver}" are replaced
+	// with beacon identity, frame type name and receiver ID
+	TopicTemplate string
+	// Publish sends message to the broker
+	Publish func(topic string, payload []byte) error
+	// OnError is called with records which couldn't be parsed, may be nil
+	OnError func(record kontaktparser.ScanRecord, err error)
+	// Now returns current time, used for scans without timestamp
+	Now func() time.Time
+
+	tracker *kontaktparser.Tracker
+}
+
+// NewBridge creates Bridge publishing to topics built from template. Beacons not seen for expiry
+// are forgotten by Expire.
+func NewBridge(topicTemplate string, expiry time.Duration, publish func(topic string, payload []byte) error) *Bridge {
+	return &Bridge{
+		TopicTemplate: topicTemplate,
+		Publish:       publish,
+		Now:           time.Now,
+		tracker:       kontaktparser.NewTracker(expiry),
+	}
+}
+
+// Expire forgets beacons not seen for longer than expiry
+func (b *Bridge) Expire(now time.Time) {
+	b.tracker.Expire(now)
+}
+
+// Handle decodes scan payload received on topic and republishes every parsed frame.
+// Payload is either advertisement hex (one per line), JSON scan message or array of them, or any
+// payload recognised by gateway package. Last topic level is used as receiver ID when scan has none.
+func (b *Bridge) Handle(topic string, payload []byte) error {
+	records, err := b.decode(payload)
+	if err != nil {
+		return err
+	}
+	receiver := topic[strings.LastIndex(topic, "/")+1:]
+	for _, record := range records {
+		if record.ReceiverID == "" {
+			record.ReceiverID = receiver
+		}
+		if record.Received.IsZero() {
+			record.Received = b.Now()
+		}
+		if err := b.publish(kontaktparser.ParseRecord(record)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Bridge) decode(payload []byte) ([]kontaktparser.ScanRecord, error) {
+	trimmed := bytes.TrimSpace(payload)
+	if len(trimmed) == 0 {
+		return nil, ErrInvalidScan
+	}
+	if trimmed[0] != '{' && trimmed[0] != '[' && trimmed[0] != '$' {
+		return decodeHex(trimmed)
+	}
+	if records, err := gateway.Decode(trimmed); err == nil {
+		return records, nil
+	} else if err != gateway.ErrUnknownFormat {
+		return nil, err
+	}
+	return decodeScanMessages(trimmed)
+}
+
+// decodeHex decodes advertisements sent as hex, one per line
+func decodeHex(payload []byte) ([]kontaktparser.ScanRecord, error) {
+	records := make([]kontaktparser.ScanRecord, 0)
+	for _, line := range strings.Split(string(payload), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		data, err := hex.DecodeString(line)
+		if err != nil {
+			return nil, ErrInvalidScan
+		}
+		records = append(records, kontaktparser.ScanRecord{Data: data})
+	}
+	return records, nil
+}
+
+// decodeScanMessages decodes JSON scan line or array of them
+func decodeScanMessages(payload []byte) ([]kontaktparser.ScanRecord, error) {
+	records, err := kontaktparser.DecodeScanLines(payload)
+	if err != nil {
+		return nil, ErrInvalidScan
+	}
+	return records, nil
+}
+
+func (b *Bridge) publish(parsed kontaktparser.ParsedRecord) error {
+	if parsed.Err != nil || parsed.Parsed == nil {
+		if b.OnError != nil {
+			err := parsed.Err
+			if err == nil {
+				err = kontaktparser.ErrNotImplemented
+			}
+			b.OnError(parsed.ScanRecord, err)
+		}
+		return nil
+	}
+
+	device := b.tracker.Ingest(parsed.MAC, parsed.RSSI, parsed.Received, parsed.Parsed)
+	if device.ID == "" {
+		// e.g. telemetry sent as hex line, which can't be linked to any beacon without MAC address
+		if b.OnError != nil {
+			b.OnError(parsed.ScanRecord, ErrUnknownBeacon)
+		}
+		return nil
+	}
+	frame, err := json.Marshal(parsed.Parsed)
+	if err != nil {
+		return err
+	}
+	message := beaconMessage{
+		ID:       device.ID,
+		Receiver: parsed.ReceiverID,
+		MAC:      parsed.MAC,
+		RSSI:     parsed.RSSI,
+		Received: parsed.Received,
+		Type:     parsed.DetectedType,
+		Frame:    frame,
+	}
+
+	model := device.Model()
+	if model != kontaktparser.UnknownModel {
+		message.Model = model.String()
+	}
+	if battery, ok := kontaktparser.BatteryPercent(parsed.Parsed, model); ok {
+		message.Battery = &battery
+	}
+	if distance, ok := kontaktparser.EstimateModelDistance(kontaktparser.PathLossModels, parsed.Parsed, model, float64(parsed.RSSI)); ok {
+		message.Distance = &distance
+		message.Proximity = kontaktparser.ProximityFromDistance(distance).String()
+	}
+
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return b.Publish(b.topic(message), payload)
+}
+
+func (b *Bridge) topic(message beaconMessage) string {
+	return strings.NewReplacer(
+		"{id}", message.ID,
+		"{type}", message.Type.String(),
+		"{receiver}", message.Receiver,
+	).Replace(b.TopicTemplate)
+}
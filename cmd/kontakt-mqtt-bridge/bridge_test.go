@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+const (
+	plainHex        = "0201060F166AFE0206010F6404616263646566"
+	scanResponseHex = "080961626364656667020A040A160DD061626364040264"
+	ibeaconHex      = "1AFF4C000215F7826DA64FA24E988024BC5B71E0893E01020304B3"
+	telemetryHex    = "0C166AFE03020A640411065BA0"
+)
+
+type published struct {
+	topic   string
+	message map[string]interface{}
+}
+
+func newTestBridge(t *testing.T) (*Bridge, *[]published) {
+	messages := make([]published, 0)
+	bridge := NewBridge("beacons/{receiver}/{id}/{type}", time.Minute, func(topic string, payload []byte) error {
+		message := make(map[string]interface{})
+		assert.Nil(t, json.Unmarshal(payload, &message))
+		messages = append(messages, published{topic: topic, message: message})
+		return nil
+	})
+	bridge.Now = func() time.Time {
+		return time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	}
+	return bridge, &messages
+}
+
+func TestBridgeHex(t *testing.T) {
+	bridge, messages := newTestBridge(t)
+	assert.Nil(t, bridge.Handle("scans/gw-1", []byte(plainHex+"\n")))
+	assert.Len(t, *messages, 1)
+
+	message := (*messages)[0]
+	assert.Equal(t, "beacons/gw-1/kontakt:abcdef/kontakt_plain", message.topic)
+	assert.Equal(t, "kontakt:abcdef", message.message["id"])
+	assert.Equal(t, "gw-1", message.message["receiver"])
+	assert.Equal(t, "2020-01-01T12:00:00Z", message.message["received"])
+	assert.Equal(t, "Smart Beacon 3", message.message["model"])
+	assert.Equal(t, float64(100), message.message["battery"])
+	frame := message.message["frame"].(map[string]interface{})
+	assert.Equal(t, "kontakt_plain", frame["type"])
+	assert.Equal(t, "abcdef", frame["unique_id"])
+}
+
+func TestBridgeHexWithoutMAC(t *testing.T) {
+	bridge, messages := newTestBridge(t)
+	failed := make([]error, 0)
+	bridge.OnError = func(record kontaktparser.ScanRecord, err error) {
+		failed = append(failed, err)
+	}
+	payload := plainHex + "\n" + ibeaconHex + "\n" + telemetryHex + "\n"
+	assert.Nil(t, bridge.Handle("scans/gw-1", []byte(payload)))
+
+	assert.Len(t, *messages, 2)
+	assert.Equal(t, "beacons/gw-1/kontakt:abcdef/kontakt_plain", (*messages)[0].topic)
+	assert.Equal(t, "beacons/gw-1/ibeacon:f7826da6-4fa2-4e98-8024-bc5b71e0893e:513:1027/ibeacon", (*messages)[1].topic)
+	assert.Nil(t, (*messages)[1].message["model"])
+	assert.Equal(t, []error{ErrUnknownBeacon}, failed)
+}
+
+func TestBridgeJSON(t *testing.T) {
+	bridge, messages := newTestBridge(t)
+	payload := `[
+		{"receiver": "gw-2", "mac": "f1:a2:b3:c4:d5:e6", "rssi": -60, "timestamp": "2020-01-01T11:00:00Z", "data": "` + plainHex + `"},
+		{"mac": "f1:a2:b3:c4:d5:e6", "rssi": -61, "data": "` + scanResponseHex + `", "scanResponse": true}
+	]`
+	assert.Nil(t, bridge.Handle("scans/gw-1", []byte(payload)))
+	assert.Len(t, *messages, 2)
+
+	assert.Equal(t, "beacons/gw-2/kontakt:abcdef/kontakt_plain", (*messages)[0].topic)
+	assert.Equal(t, "F1:A2:B3:C4:D5:E6", (*messages)[0].message["mac"])
+	assert.Equal(t, float64(-60), (*messages)[0].message["rssi"])
+	assert.Equal(t, "2020-01-01T11:00:00Z", (*messages)[0].message["received"])
+	assert.NotNil(t, (*messages)[0].message["distance"])
+
+	assert.Equal(t, "beacons/gw-1/kontakt:abcd/kontakt_scan_response", (*messages)[1].topic)
+	assert.Equal(t, true, (*messages)[1].message["frame"].(map[string]interface{})["has_identifier"])
+}
+
+func TestBridgeGatewayPayload(t *testing.T) {
+	bridge, messages := newTestBridge(t)
+	payload := "$GPRP,F1A2B3C4D5E6,C0A1B2C3D4E5,-64," + plainHex + ",1571904000"
+	assert.Nil(t, bridge.Handle("scans/ingics", []byte(payload)))
+	assert.Len(t, *messages, 1)
+	assert.Equal(t, "beacons/C0:A1:B2:C3:D4:E5/kontakt:abcdef/kontakt_plain", (*messages)[0].topic)
+}
+
+func TestBridgeErrors(t *testing.T) {
+	bridge, messages := newTestBridge(t)
+	failed := make([]error, 0)
+	bridge.OnError = func(record kontaktparser.ScanRecord, err error) {
+		failed = append(failed, err)
+	}
+
+	assert.Equal(t, ErrInvalidScan, bridge.Handle("scans/gw-1", []byte("zz")))
+	assert.Equal(t, ErrInvalidScan, bridge.Handle("scans/gw-1", []byte(`{"mac": "aa", "data": ""}`)))
+	assert.Equal(t, ErrInvalidScan, bridge.Handle("scans/gw-1", []byte(" ")))
+
+	assert.Nil(t, bridge.Handle("scans/gw-1", []byte("0201060F166AFE02")))
+	assert.Len(t, failed, 1)
+	assert.Len(t, *messages, 0)
+}
@@ -0,0 +1,83 @@
+// Command kontakt-mqtt-bridge subscribes to raw BLE scans on MQTT, decodes them and republishes
+// enriched JSON per beacon.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+func main() {
+	broker := flag.String("broker", "tcp://localhost:1883", "MQTT broker URL")
+	clientID := flag.String("client-id", "kontakt-mqtt-bridge", "MQTT client ID")
+	username := flag.String("username", "", "MQTT username")
+	password := flag.String("password", "", "MQTT password")
+	subscribe := flag.String("subscribe", "scans/#", "topic filter carrying raw scans")
+	publish := flag.String("publish", "beacons/{id}", "topic of enriched frames, {id}, {type} and {receiver} are replaced")
+	qos := flag.Int("qos", 0, "QoS of subscriptions and published messages")
+	retain := flag.Bool("retain", false, "publish enriched frames as retained messages")
+	expiry := flag.Duration("expiry", 5*time.Minute, "time after which silent beacons are forgotten")
+	flag.Parse()
+	if *expiry <= 0 {
+		log.Fatalf("expiry has to be positive, got %v", *expiry)
+	}
+
+	options := mqtt.NewClientOptions().
+		AddBroker(*broker).
+		SetClientID(*clientID).
+		SetUsername(*username).
+		SetPassword(*password).
+		SetAutoReconnect(true).
+		// publishing waits for acknowledgement, which would block ordered message handlers
+		SetOrderMatters(false)
+	client := mqtt.NewClient(options)
+
+	bridge := NewBridge(*publish, *expiry, func(topic string, payload []byte) error {
+		token := client.Publish(topic, byte(*qos), *retain, payload)
+		token.Wait()
+		return token.Error()
+	})
+	bridge.OnError = func(record kontaktparser.ScanRecord, err error) {
+		log.Printf("cannot parse scan from %s via %s: %v", record.MAC, record.ReceiverID, err)
+	}
+
+	if token := client.Connect(); token.Wait() && token.Error() != nil {
+		log.Fatalf("cannot connect to %s: %v", *broker, token.Error())
+	}
+	if err := run(client, bridge, *subscribe, byte(*qos)); err != nil {
+		log.Fatalf("cannot subscribe to %s: %v", *subscribe, err)
+	}
+	log.Printf("bridging %s to %s", *subscribe, *publish)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	ticker := time.NewTicker(*expiry)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			bridge.Expire(now)
+		case <-signals:
+			client.Disconnect(250)
+			return
+		}
+	}
+}
+
+// run subscribes bridge to scans published on topic filter
+func run(client mqtt.Client, bridge *Bridge, filter string, qos byte) error {
+	token := client.Subscribe(filter, qos, func(_ mqtt.Client, message mqtt.Message) {
+		if err := bridge.Handle(message.Topic(), message.Payload()); err != nil {
+			log.Printf("cannot handle scan from %s: %v", message.Topic(), err)
+		}
+	})
+	token.Wait()
+	return token.Error()
+}
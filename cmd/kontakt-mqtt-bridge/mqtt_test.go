@@ -0,0 +1,169 @@
+package main
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"github.com/stretchr/testify/assert"
+)
+
+// testBroker is a minimal QoS 0 MQTT 3.1.1 broker for integration tests
+type testBroker struct {
+	listener net.Listener
+	mutex    sync.Mutex
+	clients  map[net.Conn][]string
+}
+
+func newTestBroker(t *testing.T) *testBroker {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	broker := &testBroker{listener: listener, clients: make(map[net.Conn][]string)}
+	go broker.accept()
+	return broker
+}
+
+func (b *testBroker) URL() string {
+	return "tcp://" + b.listener.Addr().String()
+}
+
+func (b *testBroker) Close() {
+	b.listener.Close()
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for conn := range b.clients {
+		conn.Close()
+	}
+}
+
+func (b *testBroker) accept() {
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+		b.mutex.Lock()
+		b.clients[conn] = nil
+		b.mutex.Unlock()
+		go b.serve(conn)
+	}
+}
+
+func (b *testBroker) serve(conn net.Conn) {
+	defer func() {
+		b.mutex.Lock()
+		delete(b.clients, conn)
+		b.mutex.Unlock()
+		conn.Close()
+	}()
+	for {
+		packet, err := packets.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+		switch p := packet.(type) {
+		case *packets.ConnectPacket:
+			b.write(conn, packets.NewControlPacket(packets.Connack))
+		case *packets.SubscribePacket:
+			suback := packets.NewControlPacket(packets.Suback).(*packets.SubackPacket)
+			suback.MessageID = p.MessageID
+			b.mutex.Lock()
+			b.clients[conn] = append(b.clients[conn], p.Topics...)
+			b.mutex.Unlock()
+			for range p.Topics {
+				suback.ReturnCodes = append(suback.ReturnCodes, 0)
+			}
+			b.write(conn, suback)
+		case *packets.PublishPacket:
+			b.forward(p.TopicName, p.Payload)
+		case *packets.PingreqPacket:
+			b.write(conn, packets.NewControlPacket(packets.Pingresp))
+		case *packets.DisconnectPacket:
+			return
+		}
+	}
+}
+
+func (b *testBroker) write(conn net.Conn, packet packets.ControlPacket) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	packet.Write(conn)
+}
+
+func (b *testBroker) forward(topic string, payload []byte) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for conn, filters := range b.clients {
+		for _, filter := range filters {
+			if topicMatches(filter, topic) {
+				publish := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+				publish.TopicName = topic
+				publish.Payload = payload
+				publish.Write(conn)
+				break
+			}
+		}
+	}
+}
+
+func topicMatches(filter string, topic string) bool {
+	filterLevels := strings.Split(filter, "/")
+	topicLevels := strings.Split(topic, "/")
+	for i, level := range filterLevels {
+		if level == "#" {
+			return true
+		}
+		if i >= len(topicLevels) || (level != "+" && level != topicLevels[i]) {
+			return false
+		}
+	}
+	return len(filterLevels) == len(topicLevels)
+}
+
+func connectTestClient(t *testing.T, url string, id string) mqtt.Client {
+	client := mqtt.NewClient(mqtt.NewClientOptions().AddBroker(url).SetClientID(id).SetOrderMatters(false))
+	token := client.Connect()
+	assert.True(t, token.WaitTimeout(5*time.Second))
+	assert.Nil(t, token.Error())
+	return client
+}
+
+func TestMQTTBridge(t *testing.T) {
+	broker := newTestBroker(t)
+	defer broker.Close()
+
+	bridgeClient := connectTestClient(t, broker.URL(), "bridge")
+	defer bridgeClient.Disconnect(0)
+	bridge := NewBridge("beacons/{id}", time.Minute, func(topic string, payload []byte) error {
+		token := bridgeClient.Publish(topic, 0, false, payload)
+		token.Wait()
+		return token.Error()
+	})
+	assert.Nil(t, run(bridgeClient, bridge, "scans/#", 0))
+
+	received := make(chan mqtt.Message, 1)
+	consumer := connectTestClient(t, broker.URL(), "consumer")
+	defer consumer.Disconnect(0)
+	token := consumer.Subscribe("beacons/#", 0, func(_ mqtt.Client, message mqtt.Message) {
+		received <- message
+	})
+	assert.True(t, token.WaitTimeout(5*time.Second))
+
+	scanner := connectTestClient(t, broker.URL(), "scanner")
+	defer scanner.Disconnect(0)
+	token = scanner.Publish("scans/gw-1", 0, false, `{"mac": "f1:a2:b3:c4:d5:e6", "rssi": -60, "data": "`+plainHex+`"}`)
+	assert.True(t, token.WaitTimeout(5*time.Second))
+
+	select {
+	case message := <-received:
+		assert.Equal(t, "beacons/kontakt:abcdef", message.Topic())
+		assert.Contains(t, string(message.Payload()), `"receiver":"gw-1"`)
+		assert.Contains(t, string(message.Payload()), `"type":"kontakt_plain"`)
+	case <-time.After(5 * time.Second):
+		t.Fatal("enriched frame was not published")
+	}
+}
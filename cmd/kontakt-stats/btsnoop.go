@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+const (
+	// btsnoopMagic - identification pattern starting every btsnoop file
+	btsnoopMagic = "btsnoop\x00"
+	// btsnoopEpochDelta - microseconds between 0000-01-01 and Unix epoch, btsnoop timestamps base
+	btsnoopEpochDelta = 0x00dcddb30f2f8000
+	// btsnoopHCI - datalink type of un-encapsulated HCI packets
+	btsnoopHCI = 1001
+	// btsnoopH4 - datalink type of HCI UART (H4) packets
+	btsnoopH4 = 1002
+	// btsnoopCommandOrEvent - packet flag set for commands and events
+	btsnoopCommandOrEvent = 0x02
+
+	// h4Event - H4 packet indicator of HCI event
+	h4Event = 0x04
+	// hciLEMetaEvent - HCI event code of LE Meta event
+	hciLEMetaEvent = 0x3E
+	// hciLEAdvertisingReport - LE Meta subevent of legacy advertising report
+	hciLEAdvertisingReport = 0x02
+	// hciLEExtendedAdvertisingReport - LE Meta subevent of extended advertising report
+	hciLEExtendedAdvertisingReport = 0x0D
+	// hciScanResponse - legacy advertising report event type of scan response
+	hciScanResponse = 0x04
+	// hciExtendedScanResponse - extended advertising report event type bit of scan response
+	hciExtendedScanResponse = 0x08
+)
+
+var (
+	ErrInvalidBtsnoop = errors.New("invalid btsnoop file")
+)
+
+// readBtsnoop reads advertising reports from btsnoop capture. HCI packets other than LE advertising
+// reports are skipped, malformed reports are reported with fail.
+func readBtsnoop(r *bufio.Reader, handle func(kontaktparser.ScanRecord), fail func(reason string)) error {
+	header := make([]byte, 16)
+	if _, err := io.ReadFull(r, header); err != nil || string(header[:8]) != btsnoopMagic {
+		return ErrInvalidBtsnoop
+	}
+	datalink := binary.BigEndian.Uint32(header[12:])
+	if datalink != btsnoopHCI && datalink != btsnoopH4 {
+		return fmt.Errorf("unsupported btsnoop datalink %d", datalink)
+	}
+
+	record := make([]byte, 24)
+	for {
+		if _, err := io.ReadFull(r, record); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return ErrInvalidBtsnoop
+		}
+		packet := make([]byte, binary.BigEndian.Uint32(record[4:8]))
+		if _, err := io.ReadFull(r, packet); err != nil {
+			return ErrInvalidBtsnoop
+		}
+		flags := binary.BigEndian.Uint32(record[8:12])
+		timestamp := int64(binary.BigEndian.Uint64(record[16:24])) - btsnoopEpochDelta
+		received := time.Unix(0, timestamp*int64(time.Microsecond)).UTC()
+
+		if datalink == btsnoopH4 {
+			if len(packet) == 0 || packet[0] != h4Event {
+				continue
+			}
+			packet = packet[1:]
+		} else if flags&btsnoopCommandOrEvent == 0 {
+			continue
+		}
+		if err := readHCIEvent(packet, received, handle); err != nil {
+			fail("btsnoop: " + err.Error())
+		}
+	}
+}
+
+// readHCIEvent reads LE advertising reports from HCI event packet
+func readHCIEvent(event []byte, received time.Time, handle func(kontaktparser.ScanRecord)) error {
+	if len(event) < 4 || event[0] != hciLEMetaEvent {
+		return nil
+	}
+	params := event[2:]
+	if len(params) != int(event[1]) {
+		return kontaktparser.ErrInvalidLength
+	}
+	switch params[0] {
+	case hciLEAdvertisingReport:
+		return readAdvertisingReports(params[1:], received, handle)
+	case hciLEExtendedAdvertisingReport:
+		return readExtendedAdvertisingReports(params[1:], received, handle)
+	}
+	return nil
+}
+
+// readAdvertisingReports reads legacy advertising reports, report fields are stored as arrays
+func readAdvertisingReports(params []byte, received time.Time, handle func(kontaktparser.ScanRecord)) error {
+	count := int(params[0])
+	params = params[1:]
+	// event types, address types and addresses
+	if len(params) < count*9 {
+		return kontaktparser.ErrInvalidLength
+	}
+	eventTypes := params[:count]
+	addresses := params[2*count : 8*count]
+	lengths := params[8*count : 9*count]
+	data := params[9*count:]
+	records := make([]kontaktparser.ScanRecord, count)
+	for i := 0; i < count; i++ {
+		length := int(lengths[i])
+		if len(data) < length {
+			return kontaktparser.ErrInvalidLength
+		}
+		records[i] = kontaktparser.ScanRecord{
+			MAC:          formatAddress(addresses[6*i : 6*i+6]),
+			Received:     received,
+			Data:         data[:length],
+			ScanResponse: eventTypes[i] == hciScanResponse,
+		}
+		data = data[length:]
+	}
+	if len(data) != count {
+		return kontaktparser.ErrInvalidLength
+	}
+	for i := range records {
+		records[i].RSSI = int8(data[i])
+		handle(records[i])
+	}
+	return nil
+}
+
+// readExtendedAdvertisingReports reads extended advertising reports
+func readExtendedAdvertisingReports(params []byte, received time.Time, handle func(kontaktparser.ScanRecord)) error {
+	count := int(params[0])
+	params = params[1:]
+	for i := 0; i < count; i++ {
+		if len(params) < 24 {
+			return kontaktparser.ErrInvalidLength
+		}
+		eventType := binary.LittleEndian.Uint16(params[0:2])
+		length := int(params[23])
+		if len(params) < 24+length {
+			return kontaktparser.ErrInvalidLength
+		}
+		handle(kontaktparser.ScanRecord{
+			MAC:          formatAddress(params[3:9]),
+			RSSI:         int8(params[13]),
+			Received:     received,
+			Data:         params[24 : 24+length],
+			ScanResponse: eventType&hciExtendedScanResponse != 0,
+		})
+		params = params[24+length:]
+	}
+	return nil
+}
+
+// formatAddress formats little-endian HCI device address
+func formatAddress(address []byte) string {
+	parts := make([]string, len(address))
+	for i := range address {
+		parts[len(address)-1-i] = fmt.Sprintf("%02X", address[i])
+	}
+	return strings.Join(parts, ":")
+}
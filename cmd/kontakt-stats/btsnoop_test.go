@@ -0,0 +1,41 @@
+package main
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+func TestReadHCIEventMultipleReports(t *testing.T) {
+	// two legacy reports, fields stored as arrays: event types, address types, addresses, lengths, data, RSSIs
+	event, _ := hex.DecodeString("3E1B" + "0202" + "0004" + "0000" + "E6D5C4B3A2F1" + "E5D4C3B2A1C0" + "0302" + "020106" + "0201" + "C4C3")
+	records := make([]kontaktparser.ScanRecord, 0)
+	received := time.Unix(1571904000, 0)
+	assert.Nil(t, readHCIEvent(event, received, func(record kontaktparser.ScanRecord) {
+		records = append(records, record)
+	}))
+
+	assert.Len(t, records, 2)
+	assert.Equal(t, "F1:A2:B3:C4:D5:E6", records[0].MAC)
+	assert.Equal(t, []byte{0x02, 0x01, 0x06}, records[0].Data)
+	assert.Equal(t, int8(-60), records[0].RSSI)
+	assert.False(t, records[0].ScanResponse)
+	assert.Equal(t, "C0:A1:B2:C3:D4:E5", records[1].MAC)
+	assert.Equal(t, []byte{0x02, 0x01}, records[1].Data)
+	assert.Equal(t, int8(-61), records[1].RSSI)
+	assert.True(t, records[1].ScanResponse)
+	assert.Equal(t, received, records[1].Received)
+}
+
+func TestReadHCIEventSkipsOtherEvents(t *testing.T) {
+	event, _ := hex.DecodeString("0E0401030C00")
+	assert.Nil(t, readHCIEvent(event, time.Time{}, func(kontaktparser.ScanRecord) {
+		t.Fail()
+	}))
+
+	event, _ = hex.DecodeString("3E0302010000")
+	assert.Equal(t, kontaktparser.ErrInvalidLength, readHCIEvent(event, time.Time{}, func(kontaktparser.ScanRecord) {}))
+}
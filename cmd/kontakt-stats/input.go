@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"strings"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+	"github.com/sz33psz/kontakt-beacon-parser/gateway"
+)
+
+// readLog reads scan log in btsnoop, JSON lines or hex per line format, detected from its content.
+// Every scan is passed to handle, lines which couldn't be read are reported with fail.
+func readLog(r *bufio.Reader, handle func(kontaktparser.ScanRecord), fail func(reason string)) error {
+	if magic, err := r.Peek(len(btsnoopMagic)); err == nil && string(magic) == btsnoopMagic {
+		return readBtsnoop(r, handle, fail)
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		if line[0] == '{' || line[0] == '[' || line[0] == '$' {
+			readJSONLine(line, handle, fail)
+		} else {
+			readHexLine(string(line), handle, fail)
+		}
+	}
+	return scanner.Err()
+}
+
+func readHexLine(line string, handle func(kontaktparser.ScanRecord), fail func(reason string)) {
+	data, err := hex.DecodeString(strings.TrimPrefix(line, "0x"))
+	if err != nil || len(data) == 0 {
+		fail("invalid hex")
+		return
+	}
+	handle(kontaktparser.ScanRecord{Data: data})
+}
+
+// readJSONLine reads scan line or any payload supported by gateway package
+func readJSONLine(line []byte, handle func(kontaktparser.ScanRecord), fail func(reason string)) {
+	if records, err := gateway.Decode(line); err == nil {
+		for _, record := range records {
+			handle(record)
+		}
+		return
+	} else if err != gateway.ErrUnknownFormat {
+		fail("invalid gateway payload")
+		return
+	}
+
+	records, err := kontaktparser.DecodeScanLines(line)
+	if err == kontaktparser.ErrInvalidScanLineData {
+		fail("invalid hex")
+		return
+	} else if err != nil {
+		fail("invalid json")
+		return
+	}
+	for _, record := range records {
+		handle(record)
+	}
+}
@@ -0,0 +1,133 @@
+// Command kontakt-stats summarises recorded scan logs.
+//
+// Logs are read from files given as arguments or from standard input. Supported formats are btsnoop
+// captures, JSON lines with {"receiver", "mac", "rssi", "timestamp", "data", "scanResponse"} scans or
+// gateway payloads, and advertisement hex per line. Report lists beacons with frame types, advertising
+// interval estimate, RSSI distribution and battery level, together with parse errors by reason. Beacons are
+// counted per device ID of kontaktparser.Tracker, frames of beacon heard under several MAC addresses are
+// reported together once its identity links them.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("kontakt-stats", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	asJSON := flags.Bool("json", false, "print report as JSON")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: kontakt-stats [-json] [file ...]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	stats := NewStats()
+	if flags.NArg() == 0 {
+		if err := readLog(bufio.NewReader(stdin), stats.Add, stats.Fail); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	}
+	for _, name := range flags.Args() {
+		file, err := os.Open(name)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		err = readLog(bufio.NewReader(file), stats.Add, stats.Fail)
+		file.Close()
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", name, err)
+			return 1
+		}
+	}
+
+	report := stats.Report()
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		return 0
+	}
+	printReport(stdout, report)
+	return 0
+}
+
+func printReport(w io.Writer, report Report) {
+	fmt.Fprintf(w, "packets: %d, parsed: %d, unknown: %d, errors: %d\n", report.Packets, report.Parsed,
+		report.Unknown, report.Packets-report.Parsed-report.Unknown)
+	fmt.Fprintf(w, "beacons: %d\n\n", len(report.Beacons))
+
+	table := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(table, "ID\tMAC\tMODEL\tPACKETS\tINTERVAL\tRSSI MIN/MEDIAN/MAX\tBATTERY\tFRAMES")
+	for _, beacon := range report.Beacons {
+		interval := "-"
+		if beacon.Interval != nil {
+			interval = fmt.Sprintf("%.2fs", *beacon.Interval)
+		}
+		rssi := "-"
+		if beacon.RSSI != nil {
+			rssi = fmt.Sprintf("%d/%.1f/%d", beacon.RSSI.Min, beacon.RSSI.Median, beacon.RSSI.Max)
+		}
+		battery := "-"
+		if beacon.Battery != nil {
+			battery = fmt.Sprintf("%.0f%%", *beacon.Battery)
+		}
+		mac := beacon.MAC
+		if len(beacon.MACs) > 0 {
+			mac = strings.Join(beacon.MACs, ",")
+		}
+		fmt.Fprintf(table, "%s\t%s\t%s\t%d\t%s\t%s\t%s\t%s\n", beacon.ID, orDash(mac), orDash(beacon.Model),
+			beacon.Packets, interval, rssi, battery, frameCounts(beacon.FrameTypes))
+	}
+	table.Flush()
+
+	if len(report.Errors) > 0 {
+		fmt.Fprintln(w, "\nerrors:")
+		reasons := make([]string, 0, len(report.Errors))
+		for reason := range report.Errors {
+			reasons = append(reasons, reason)
+		}
+		sort.Strings(reasons)
+		for _, reason := range reasons {
+			fmt.Fprintf(w, "  %s: %d\n", reason, report.Errors[reason])
+		}
+	}
+}
+
+// frameCounts formats frame counts as "type=count" pairs sorted by type name
+func frameCounts(counts map[kontaktparser.DetectedType]int) string {
+	parts := make([]string, 0, len(counts))
+	for typ, count := range counts {
+		parts = append(parts, fmt.Sprintf("%s=%d", typ, count))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, " ")
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
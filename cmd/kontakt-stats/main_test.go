@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+func runStats(t *testing.T, args []string, stdin string) (int, string) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	code := run(args, strings.NewReader(stdin), stdout, stderr)
+	return code, stdout.String()
+}
+
+func jsonReport(t *testing.T, file string) Report {
+	code, stdout := runStats(t, []string{"-json", file}, "")
+	assert.Equal(t, 0, code)
+	report := Report{}
+	assert.Nil(t, json.Unmarshal([]byte(stdout), &report))
+	return report
+}
+
+func TestHexLog(t *testing.T) {
+	report := jsonReport(t, "testdata/scans.hex")
+	assert.Equal(t, 8, report.Packets)
+	assert.Equal(t, 5, report.Parsed)
+	assert.Equal(t, 1, report.Unknown)
+	assert.Equal(t, map[string]int{"EOF": 1, "invalid hex": 1}, report.Errors)
+	assert.Equal(t, 2, report.FrameTypes[kontaktparser.KontaktPlain])
+	assert.Equal(t, 1, report.FrameTypes[kontaktparser.KontaktScanResponse])
+
+	assert.Len(t, report.Beacons, 4)
+	plain := report.Beacons[2]
+	assert.Equal(t, "kontakt:abcdef", plain.ID)
+	assert.Equal(t, 2, plain.Packets)
+	assert.Nil(t, plain.Interval)
+	assert.Nil(t, plain.RSSI)
+	assert.Equal(t, 100.0, *plain.Battery)
+	assert.Equal(t, "unidentified", report.Beacons[3].ID)
+}
+
+func TestJSONLinesLog(t *testing.T) {
+	report := jsonReport(t, "testdata/scans.jsonl")
+	assert.Equal(t, 11, report.Packets)
+	assert.Equal(t, 9, report.Parsed)
+	assert.Equal(t, map[string]int{"EOF": 1, "invalid json": 1}, report.Errors)
+
+	assert.Len(t, report.Beacons, 2)
+	ibeacon := report.Beacons[0]
+	assert.Equal(t, "C0:A1:B2:C3:D4:E5", ibeacon.MAC)
+	assert.Equal(t, int8(-66), ibeacon.RSSI.Min)
+
+	kontakt := report.Beacons[1]
+	assert.Equal(t, "kontakt:abcdef", kontakt.ID)
+	assert.Equal(t, "Smart Beacon 3", kontakt.Model)
+	assert.Equal(t, map[kontaktparser.DetectedType]int{kontaktparser.KontaktPlain: 4, kontaktparser.KontaktTelemetry: 4}, kontakt.FrameTypes)
+	// copies heard by the second receiver don't shorten the interval
+	assert.Equal(t, 0.5, *kontakt.Interval)
+	assert.Equal(t, int8(-78), kontakt.RSSI.Min)
+	assert.Equal(t, int8(-55), kontakt.RSSI.Max)
+	assert.Equal(t, -66.5, kontakt.RSSI.Median)
+	assert.Equal(t, -66.5, kontakt.RSSI.Mean)
+}
+
+func TestBtsnoopLog(t *testing.T) {
+	report := jsonReport(t, "testdata/capture.btsnoop")
+	assert.Equal(t, 13, report.Packets)
+	assert.Equal(t, 12, report.Parsed)
+	assert.Equal(t, map[string]int{"btsnoop: packet has invalid length": 1}, report.Errors)
+
+	assert.Len(t, report.Beacons, 3)
+	assert.Equal(t, "ibeacon:f7826da6-4fa2-4e98-8024-bc5b71e0893e:513:1027", report.Beacons[0].ID)
+	assert.Equal(t, 0.5, *report.Beacons[0].Interval)
+	assert.Equal(t, int8(-72), report.Beacons[0].RSSI.Min)
+
+	scanResponse := report.Beacons[1]
+	assert.Equal(t, "D0:A1:B2:C3:D4:E5", scanResponse.MAC)
+	assert.Equal(t, 5, scanResponse.FrameTypes[kontaktparser.KontaktScanResponse])
+	assert.Equal(t, 2.0, *scanResponse.Interval)
+
+	plain := report.Beacons[2]
+	assert.Equal(t, "F1:A2:B3:C4:D5:E6", plain.MAC)
+	assert.Equal(t, 1.0, *plain.Interval)
+	assert.Equal(t, int8(-60), plain.RSSI.Max)
+}
+
+func TestTextReport(t *testing.T) {
+	code, stdout := runStats(t, nil, "0201060F166AFE0206010F6404616263646566\nnothex\n")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "packets: 2, parsed: 1, unknown: 0, errors: 1\n")
+	assert.Contains(t, stdout, "kontakt:abcdef")
+	assert.Contains(t, stdout, "kontakt_plain=1")
+	assert.Contains(t, stdout, "  invalid hex: 1\n")
+}
+
+func TestBeaconHeardUnderSeveralMACs(t *testing.T) {
+	log := `{"mac": "11:11:11:11:11:11", "rssi": -60, "timestamp": "2019-10-24T08:00:00Z", "data": "0201060F166AFE0206010F6404616263646566"}
+{"mac": "22:22:22:22:22:22", "rssi": -70, "timestamp": "2019-10-24T08:00:01Z", "data": "0201060F166AFE0206010F6404616263646566"}
+`
+	code, stdout := runStats(t, []string{"-json"}, log)
+	assert.Equal(t, 0, code)
+	report := Report{}
+	assert.Nil(t, json.Unmarshal([]byte(stdout), &report))
+	assert.Len(t, report.Beacons, 1)
+	beacon := report.Beacons[0]
+	assert.Equal(t, "kontakt:abcdef", beacon.ID)
+	assert.Equal(t, "", beacon.MAC)
+	assert.Equal(t, []string{"11:11:11:11:11:11", "22:22:22:22:22:22"}, beacon.MACs)
+	assert.Equal(t, 2, beacon.Packets)
+	assert.Equal(t, int8(-70), beacon.RSSI.Min)
+	assert.Equal(t, 1.0, *beacon.Interval)
+
+	code, stdout = runStats(t, nil, log)
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "beacons: 1\n")
+	assert.Contains(t, stdout, "11:11:11:11:11:11,22:22:22:22:22:22")
+}
+
+func TestInvalidInput(t *testing.T) {
+	code, _ := runStats(t, []string{"testdata/missing.log"}, "")
+	assert.Equal(t, 1, code)
+
+	code, _ = runStats(t, nil, "btsnoop\x00\x00\x00")
+	assert.Equal(t, 1, code)
+}
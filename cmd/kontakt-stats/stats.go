@@ -0,0 +1,279 @@
+package main
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+// minInterval - packets closer than this are copies heard by another receiver or on another channel
+const minInterval = 20 * time.Millisecond
+
+// unidentified - key of frames carrying neither identity nor MAC address
+const unidentified = "unidentified"
+
+// Report is a summary of scan log
+type Report struct {
+	Packets    int                                `json:"packets"`
+	Parsed     int                                `json:"parsed"`
+	Unknown    int                                `json:"unknown"`
+	FrameTypes map[kontaktparser.DetectedType]int `json:"frame_types"`
+	Errors     map[string]int                     `json:"errors"`
+	Beacons    []BeaconReport                     `json:"beacons"`
+}
+
+// BeaconReport is a summary of frames received from a single beacon, frames heard under several MAC
+// addresses are reported together when tracker links them to the same device
+type BeaconReport struct {
+	ID         string                             `json:"id"`
+	MAC        string                             `json:"mac,omitempty"`
+	MACs       []string                           `json:"macs,omitempty"`
+	Model      string                             `json:"model,omitempty"`
+	Packets    int                                `json:"packets"`
+	FrameTypes map[kontaktparser.DetectedType]int `json:"frame_types"`
+	Interval   *float64                           `json:"interval_seconds,omitempty"`
+	RSSI       *RSSIReport                        `json:"rssi,omitempty"`
+	Battery    *float64                           `json:"battery,omitempty"`
+}
+
+// RSSIReport is a distribution of RSSI values
+type RSSIReport struct {
+	Min    int8    `json:"min"`
+	Max    int8    `json:"max"`
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+	StdDev float64 `json:"stddev"`
+}
+
+// beaconStats accumulates frames of a single beacon, keyed by MAC address or by frame identity
+type beaconStats struct {
+	mac        string
+	id         string
+	frameTypes map[kontaktparser.DetectedType]int
+	times      []time.Time
+	rssi       []int8
+	battery    float64
+	hasBattery bool
+	batteryAt  int
+}
+
+// Stats accumulates scan log statistics
+type Stats struct {
+	report  Report
+	beacons map[string]*beaconStats
+	order   []string
+	tracker *kontaktparser.Tracker
+}
+
+// NewStats creates empty Stats
+func NewStats() *Stats {
+	return &Stats{
+		report: Report{
+			FrameTypes: make(map[kontaktparser.DetectedType]int),
+			Errors:     make(map[string]int),
+		},
+		beacons: make(map[string]*beaconStats),
+		// log spans arbitrary time, devices never expire
+		tracker: kontaktparser.NewTracker(math.MaxInt64),
+	}
+}
+
+// Fail counts packet which couldn't be read
+func (s *Stats) Fail(reason string) {
+	s.report.Packets++
+	s.report.Errors[reason]++
+}
+
+// Add parses scan and accumulates its result. Advertisements not recognised by parser are retried as scan
+// responses, as hex logs don't tell them apart.
+func (s *Stats) Add(record kontaktparser.ScanRecord) {
+	s.report.Packets++
+	parsed := kontaktparser.ParseRecord(record)
+	if !record.ScanResponse && (parsed.Err != nil || parsed.DetectedType == kontaktparser.Unknown) {
+		record.ScanResponse = true
+		if scanResponse := kontaktparser.ParseRecord(record); scanResponse.Err == nil && scanResponse.DetectedType == kontaktparser.KontaktScanResponse {
+			parsed = scanResponse
+		}
+	}
+	if parsed.Err != nil {
+		s.report.Errors[parsed.Err.Error()]++
+		return
+	}
+	if parsed.DetectedType == kontaktparser.Unknown {
+		s.report.Unknown++
+		return
+	}
+	s.report.Parsed++
+	s.report.FrameTypes[parsed.DetectedType]++
+
+	key := parsed.MAC
+	if key == "" {
+		if id, ok := kontaktparser.FrameIdentity(parsed.Parsed); ok {
+			key = id
+		} else {
+			key = unidentified
+		}
+	}
+	beacon, ok := s.beacons[key]
+	if !ok {
+		beacon = &beaconStats{mac: parsed.MAC, frameTypes: make(map[kontaktparser.DetectedType]int)}
+		if parsed.MAC == "" {
+			beacon.id = key
+		}
+		s.beacons[key] = beacon
+		s.order = append(s.order, key)
+	}
+	beacon.frameTypes[parsed.DetectedType]++
+	if !parsed.Received.IsZero() {
+		beacon.times = append(beacon.times, parsed.Received)
+	}
+	model := kontaktparser.UnknownModel
+	if parsed.MAC != "" {
+		beacon.rssi = append(beacon.rssi, parsed.RSSI)
+		model = s.tracker.Ingest(parsed.MAC, parsed.RSSI, parsed.Received, parsed.Parsed).Model()
+	}
+	if battery, ok := kontaktparser.BatteryPercent(parsed.Parsed, model); ok {
+		beacon.battery = battery
+		beacon.hasBattery = true
+		beacon.batteryAt = s.report.Packets
+	}
+}
+
+// device returns tracked device which frames of beacon belong to
+func (s *Stats) device(beacon *beaconStats) (kontaktparser.Device, bool) {
+	if beacon.mac == "" {
+		return kontaktparser.Device{}, false
+	}
+	return s.tracker.DeviceByMAC(beacon.mac)
+}
+
+// Report returns summary of all scans added so far. Beacons are reported per device ID given by tracker,
+// so beacon heard under several MAC addresses is reported once, beacons are sorted by ID.
+func (s *Stats) Report() Report {
+	report := s.report
+	groups := make(map[string]*beaconStats)
+	macs := make(map[string][]string)
+	models := make(map[string]kontaktparser.DeviceModel)
+	var order []string
+	for _, key := range s.order {
+		beacon := s.beacons[key]
+		id := beacon.id
+		model := kontaktparser.UnknownModel
+		if device, ok := s.device(beacon); ok {
+			id = device.ID
+			model = device.Model()
+		}
+		group, ok := groups[id]
+		if !ok {
+			group = &beaconStats{id: id, frameTypes: make(map[kontaktparser.DetectedType]int)}
+			groups[id] = group
+			order = append(order, id)
+		}
+		if beacon.mac != "" {
+			macs[id] = append(macs[id], beacon.mac)
+		}
+		if model != kontaktparser.UnknownModel {
+			models[id] = model
+		}
+		for frameType, count := range beacon.frameTypes {
+			group.frameTypes[frameType] += count
+		}
+		group.times = append(group.times, beacon.times...)
+		group.rssi = append(group.rssi, beacon.rssi...)
+		if beacon.hasBattery && (!group.hasBattery || beacon.batteryAt > group.batteryAt) {
+			group.battery = beacon.battery
+			group.hasBattery = true
+			group.batteryAt = beacon.batteryAt
+		}
+	}
+
+	report.Beacons = make([]BeaconReport, 0, len(groups))
+	for _, id := range order {
+		group := groups[id]
+		beaconReport := BeaconReport{
+			ID:         id,
+			FrameTypes: group.frameTypes,
+			Interval:   interval(group.times),
+			RSSI:       rssiReport(group.rssi),
+		}
+		for _, count := range group.frameTypes {
+			beaconReport.Packets += count
+		}
+		if len(macs[id]) == 1 {
+			beaconReport.MAC = macs[id][0]
+		} else if len(macs[id]) > 1 {
+			beaconReport.MACs = macs[id]
+			sort.Strings(beaconReport.MACs)
+		}
+		if model, ok := models[id]; ok {
+			beaconReport.Model = model.String()
+		}
+		if group.hasBattery {
+			battery := group.battery
+			beaconReport.Battery = &battery
+		}
+		report.Beacons = append(report.Beacons, beaconReport)
+	}
+	sort.SliceStable(report.Beacons, func(i, j int) bool {
+		return report.Beacons[i].ID < report.Beacons[j].ID
+	})
+	return report
+}
+
+// interval estimates advertising interval in seconds as a median of times between consecutive packets
+func interval(times []time.Time) *float64 {
+	sorted := make([]time.Time, len(times))
+	copy(sorted, times)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Before(sorted[j])
+	})
+	deltas := make([]float64, 0, len(sorted))
+	for i := 1; i < len(sorted); i++ {
+		if delta := sorted[i].Sub(sorted[i-1]); delta >= minInterval {
+			deltas = append(deltas, delta.Seconds())
+		}
+	}
+	if len(deltas) == 0 {
+		return nil
+	}
+	result := median(deltas)
+	return &result
+}
+
+func rssiReport(values []int8) *RSSIReport {
+	if len(values) == 0 {
+		return nil
+	}
+	report := &RSSIReport{Min: values[0], Max: values[0]}
+	floats := make([]float64, len(values))
+	for i, value := range values {
+		if value < report.Min {
+			report.Min = value
+		}
+		if value > report.Max {
+			report.Max = value
+		}
+		floats[i] = float64(value)
+		report.Mean += floats[i]
+	}
+	report.Mean /= float64(len(values))
+	for _, value := range floats {
+		report.StdDev += (value - report.Mean) * (value - report.Mean)
+	}
+	report.StdDev = math.Sqrt(report.StdDev / float64(len(values)))
+	report.Median = median(floats)
+	return report
+}
+
+func median(values []float64) float64 {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
@@ -0,0 +1,93 @@
+package kontaktparser
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// csvRecordColumns are columns preceding frame values in every CSV file
+var csvRecordColumns = []string{"time", "receiver", "mac", "rssi", "id"}
+
+// CSVColumns returns header of CSV file with frames of given type. Columns of each frame type are stable,
+// new columns are only ever appended. ErrInvalidFrameType is returned for types which can't be exported.
+func CSVColumns(typ DetectedType) ([]string, error) {
+	columns, ok := exportColumns[typ]
+	if !ok {
+		return nil, ErrInvalidFrameType
+	}
+	header := make([]string, 0, len(csvRecordColumns)+len(columns))
+	header = append(header, csvRecordColumns...)
+	return append(header, columns...), nil
+}
+
+// CSVWriter writes parsed records of a single frame type as RFC 4180 CSV. Header is written before
+// the first record. Values missing in the frame (e.g. sensors not reported by the beacon) are left empty.
+type CSVWriter struct {
+	typ           DetectedType
+	header        []string
+	w             *csv.Writer
+	headerWritten bool
+}
+
+// NewCSVWriter creates CSVWriter writing frames of given type to w
+func NewCSVWriter(w io.Writer, typ DetectedType) (*CSVWriter, error) {
+	header, err := CSVColumns(typ)
+	if err != nil {
+		return nil, err
+	}
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = true
+	return &CSVWriter{typ: typ, header: header, w: writer}, nil
+}
+
+// Write writes a single row with record values. ErrNotExportable is returned for records which failed
+// parsing and ErrFrameTypeMismatch for frames of other type than the writer was created for.
+func (c *CSVWriter) Write(record ParsedRecord) error {
+	typ, err := exportFrameType(record)
+	if err != nil {
+		return err
+	}
+	if typ != c.typ {
+		return ErrFrameTypeMismatch
+	}
+	if !c.headerWritten {
+		if err := c.w.Write(c.header); err != nil {
+			return err
+		}
+		c.headerWritten = true
+	}
+
+	row := make([]string, 0, len(c.header))
+	received := ""
+	if !record.Received.IsZero() {
+		received = record.Received.UTC().Format(time.RFC3339Nano)
+	}
+	row = append(row, received, record.ReceiverID, strings.ToUpper(record.MAC),
+		strconv.Itoa(int(record.RSSI)), exportID(record))
+	values := exportValues(record.Parsed)
+	for _, column := range c.header[len(csvRecordColumns):] {
+		row = append(row, formatCSVValue(values[column]))
+	}
+	if err := c.w.Write(row); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func formatCSVValue(value interface{}) string {
+	switch v := value.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return formatFloat(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return v
+	}
+	return ""
+}
@@ -0,0 +1,66 @@
+package kontaktparser
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSVColumns(t *testing.T) {
+	columns, err := CSVColumns(EddystoneUID)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"time", "receiver", "mac", "rssi", "id", "tx_power_0m", "namespace", "instance_id"}, columns)
+
+	_, err = CSVColumns(Unknown)
+	assert.Equal(t, ErrInvalidFrameType, err)
+}
+
+func TestCSVWriter(t *testing.T) {
+	buffer := bytes.Buffer{}
+	writer, err := NewCSVWriter(&buffer, KontaktScanResponse)
+	assert.Nil(t, err)
+
+	parser := parseFixture(t, scanResponseFixture, true)
+	record := exportRecord(parser)
+	assert.Nil(t, writer.Write(record))
+	parser.Parsed.(*KontaktIOScanResponse).Name = "hall, \"east\""
+	assert.Nil(t, writer.Write(record))
+
+	assert.Equal(t, "time,receiver,mac,rssi,id,name,tx_power,firmware,battery_level,unique_id\r\n"+
+		"2020-09-13T12:26:40.000000005Z,gw 1,AA:BB:CC:DD:EE:FF,-60,kontakt:abcd,abcdefg,4,4.2,100,abcd\r\n"+
+		"2020-09-13T12:26:40.000000005Z,gw 1,AA:BB:CC:DD:EE:FF,-60,kontakt:abcd,\"hall, \"\"east\"\"\",4,4.2,100,abcd\r\n",
+		buffer.String())
+}
+
+func TestCSVWriterMissingValues(t *testing.T) {
+	buffer := bytes.Buffer{}
+	writer, err := NewCSVWriter(&buffer, KontaktTelemetry)
+	assert.Nil(t, err)
+	assert.Nil(t, writer.Write(exportRecord(parseFixture(t, frameFixtures[KontaktTelemetry], false))))
+
+	rows, err := csv.NewReader(&buffer).ReadAll()
+	assert.Nil(t, err)
+	assert.Len(t, rows, 2)
+	assert.Len(t, rows[1], len(rows[0]))
+	values := make(map[string]string)
+	for i, column := range rows[0] {
+		values[column] = rows[1][i]
+	}
+	assert.Equal(t, "100", values["light_level"])
+	assert.Equal(t, "6", values["click_id"])
+	assert.Equal(t, "", values["temperature"])
+}
+
+func TestCSVWriterFrameTypeMismatch(t *testing.T) {
+	buffer := bytes.Buffer{}
+	writer, err := NewCSVWriter(&buffer, KontaktPlain)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrFrameTypeMismatch, writer.Write(exportRecord(parseFixture(t, frameFixtures[IBeacon], false))))
+	assert.Equal(t, ErrNotExportable, writer.Write(ParsedRecord{Err: ErrInvalidPreamble}))
+	assert.Empty(t, buffer.String())
+
+	_, err = NewCSVWriter(&buffer, Unknown)
+	assert.Equal(t, ErrInvalidFrameType, err)
+}
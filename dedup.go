@@ -0,0 +1,144 @@
+package kontaktparser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DedupedRecord is a packet heard by one or more receivers, possibly on several advertising channels
+type DedupedRecord struct {
+	// Record is the copy heard with the best RSSI
+	Record    ScanRecord
+	FirstSeen time.Time
+	// Receivers lists IDs of receivers which heard the packet
+	Receivers []string
+	// Channels lists advertising channels packet was heard on, if reported by receivers
+	Channels []AdvertisingChannel
+	// Count is a number of collapsed copies
+	Count int
+}
+
+// Deduplicator collapses copies of the same packet sent by the same device and heard within window.
+// Kontakt.io Location packets sent on different channels are treated as copies.
+// Collapsed record is passed to output when window passes. Deduplicator is safe for concurrent use.
+type Deduplicator struct {
+	window  time.Duration
+	output  func(DedupedRecord)
+	mutex   sync.Mutex
+	pending map[string]*DedupedRecord
+}
+
+// NewDeduplicator creates Deduplicator collapsing copies heard within window and passing results to output
+func NewDeduplicator(window time.Duration, output func(DedupedRecord)) *Deduplicator {
+	return &Deduplicator{
+		window:  window,
+		output:  output,
+		pending: make(map[string]*DedupedRecord),
+	}
+}
+
+// Add adds record, first flushing records which window passed before it was received.
+// It returns true when record is the first copy of a packet.
+func (d *Deduplicator) Add(record ScanRecord) bool {
+	key, channel := dedupKey(&record)
+	if record.Channel == UnknownChannel {
+		record.Channel = channel
+	}
+
+	d.mutex.Lock()
+	ready := d.ready(record.Received)
+	deduped, ok := d.pending[key]
+	if !ok {
+		deduped = &DedupedRecord{Record: record, FirstSeen: record.Received}
+		d.pending[key] = deduped
+	} else if record.RSSI > deduped.Record.RSSI {
+		deduped.Record = record
+	}
+	deduped.Count++
+	deduped.Receivers = appendReceiver(deduped.Receivers, record.ReceiverID)
+	deduped.Channels = appendChannel(deduped.Channels, record.Channel)
+	d.mutex.Unlock()
+
+	d.emit(ready)
+	return !ok
+}
+
+// Flush passes to output records which window passed before now. It should be called periodically
+// when records are not added continuously.
+func (d *Deduplicator) Flush(now time.Time) {
+	d.mutex.Lock()
+	ready := d.ready(now)
+	d.mutex.Unlock()
+	d.emit(ready)
+}
+
+// FlushAll passes all pending records to output
+func (d *Deduplicator) FlushAll() {
+	d.mutex.Lock()
+	ready := make([]*DedupedRecord, 0, len(d.pending))
+	for key, deduped := range d.pending {
+		ready = append(ready, deduped)
+		delete(d.pending, key)
+	}
+	d.mutex.Unlock()
+	d.emit(ready)
+}
+
+func (d *Deduplicator) ready(now time.Time) []*DedupedRecord {
+	ready := make([]*DedupedRecord, 0)
+	for key, deduped := range d.pending {
+		if now.Sub(deduped.FirstSeen) >= d.window {
+			ready = append(ready, deduped)
+			delete(d.pending, key)
+		}
+	}
+	return ready
+}
+
+func (d *Deduplicator) emit(ready []*DedupedRecord) {
+	sort.Slice(ready, func(i, j int) bool {
+		return ready[i].FirstSeen.Before(ready[j].FirstSeen)
+	})
+	for _, deduped := range ready {
+		d.output(*deduped)
+	}
+}
+
+// dedupKey identifies packet by device MAC and payload. Channel byte of Kontakt.io Location packet is
+// excluded from the key and returned separately.
+func dedupKey(record *ScanRecord) (string, AdvertisingChannel) {
+	mac := strings.ToUpper(record.MAC)
+	if !record.ScanResponse {
+		parser := New(record.Data)
+		if err := parser.ParseAdvertisement(); err == nil && parser.DetectedType == KontaktLocation {
+			location := parser.Parsed.(*KontaktLocationAdvertisement)
+			key := fmt.Sprintf("%v|location|%v|%v|%v|%v", mac, location.TxPower, location.DeviceModel, location.Flags, location.UniqueID)
+			return key, location.Channel()
+		}
+	}
+	return fmt.Sprintf("%v|%v|%x", mac, record.ScanResponse, record.Data), UnknownChannel
+}
+
+func appendReceiver(receivers []string, id string) []string {
+	for _, receiver := range receivers {
+		if receiver == id {
+			return receivers
+		}
+	}
+	return append(receivers, id)
+}
+
+func appendChannel(channels []AdvertisingChannel, channel AdvertisingChannel) []AdvertisingChannel {
+	if channel == UnknownChannel {
+		return channels
+	}
+	for _, c := range channels {
+		if c == channel {
+			return channels
+		}
+	}
+	return append(channels, channel)
+}
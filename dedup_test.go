@@ -0,0 +1,75 @@
+package kontaktparser
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var dedupStart = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func dedupRecord(t *testing.T, receiver string, rssi int8, offset time.Duration, dataHex string) ScanRecord {
+	data, err := hex.DecodeString(dataHex)
+	assert.Nil(t, err)
+	return ScanRecord{
+		ReceiverID: receiver,
+		MAC:        "aa:bb:cc:dd:ee:ff",
+		RSSI:       rssi,
+		Received:   dedupStart.Add(offset),
+		Data:       data,
+	}
+}
+
+func TestDeduplicatorCollapsesCopies(t *testing.T) {
+	output := make([]DedupedRecord, 0)
+	dedup := NewDeduplicator(time.Second, func(record DedupedRecord) {
+		output = append(output, record)
+	})
+
+	plain := "0F166AFE0206010F6404616263646566"
+	assert.True(t, dedup.Add(dedupRecord(t, "gw-1", -70, 0, plain)))
+	assert.False(t, dedup.Add(dedupRecord(t, "gw-2", -60, 100*time.Millisecond, plain)))
+	assert.False(t, dedup.Add(dedupRecord(t, "gw-1", -65, 200*time.Millisecond, plain)))
+	assert.Equal(t, 0, len(output))
+
+	dedup.Flush(dedupStart.Add(time.Second))
+	assert.Equal(t, 1, len(output))
+	assert.Equal(t, 3, output[0].Count)
+	assert.Equal(t, int8(-60), output[0].Record.RSSI)
+	assert.Equal(t, "gw-2", output[0].Record.ReceiverID)
+	assert.Equal(t, []string{"gw-1", "gw-2"}, output[0].Receivers)
+	assert.Equal(t, dedupStart, output[0].FirstSeen)
+}
+
+func TestDeduplicatorLocationChannels(t *testing.T) {
+	output := make([]DedupedRecord, 0)
+	dedup := NewDeduplicator(time.Second, func(record DedupedRecord) {
+		output = append(output, record)
+	})
+
+	dedup.Add(dedupRecord(t, "gw-1", -70, 0, "0E166AFE05F4250A01414243444546"))
+	dedup.Add(dedupRecord(t, "gw-1", -70, 10*time.Millisecond, "0E166AFE05F4260A01414243444546"))
+	dedup.Add(dedupRecord(t, "gw-1", -70, 20*time.Millisecond, "0E166AFE05F4270A01414243444546"))
+	dedup.FlushAll()
+
+	assert.Equal(t, 1, len(output))
+	assert.Equal(t, []AdvertisingChannel{Channel37, Channel38, Channel39}, output[0].Channels)
+}
+
+func TestDeduplicatorSeparatesPayloads(t *testing.T) {
+	output := make([]DedupedRecord, 0)
+	dedup := NewDeduplicator(time.Second, func(record DedupedRecord) {
+		output = append(output, record)
+	})
+
+	assert.True(t, dedup.Add(dedupRecord(t, "gw-1", -70, 0, "07166AFE03020A64")))
+	assert.True(t, dedup.Add(dedupRecord(t, "gw-1", -70, 0, "07166AFE03020A65")))
+	// window of the first copies passed, so it's a new packet
+	assert.True(t, dedup.Add(dedupRecord(t, "gw-1", -70, 2*time.Second, "07166AFE03020A64")))
+	assert.Equal(t, 2, len(output))
+
+	dedup.FlushAll()
+	assert.Equal(t, 3, len(output))
+}
@@ -0,0 +1,190 @@
+package kontaktparser
+
+import "math"
+
+// eddystoneReferenceLoss is a signal loss at 1m, used to convert Eddystone's 0m calibration to 1m
+const eddystoneReferenceLoss = 41
+
+// kontaktTxPowerLevels maps Kontakt.io TX power level (0-7) to transmit power in dBm
+var kontaktTxPowerLevels = []int8{-30, -20, -16, -12, -8, -4, 0, 4}
+
+// KontaktTxPowerLevelToDBm converts Kontakt.io TX power level sent in Secure Profile packets to dBm
+func KontaktTxPowerLevelToDBm(level int8) (int8, bool) {
+	if level < 0 || int(level) >= len(kontaktTxPowerLevels) {
+		return 0, false
+	}
+	return kontaktTxPowerLevels[level], true
+}
+
+// ReferenceRSSI returns RSSI expected 1m from the beacon, derived from calibration fields of parsed
+// frame (Parser.Parsed). False is returned for frames without calibration.
+func ReferenceRSSI(parsed interface{}) (int8, bool) {
+	switch frame := parsed.(type) {
+	case *IBeaconAdvertisement:
+		return frame.CalibratedRssi, true
+	case *EddystoneUIDPacket:
+		return referenceFromTxPower(frame.TxPower0M), true
+	case *EddystoneURLPacket:
+		return referenceFromTxPower(frame.TxPower0M), true
+	case *EddystoneEIDPacket:
+		return referenceFromTxPower(frame.TxPower0M), true
+	case *KontaktPlainAdvertisement:
+		if dbm, ok := KontaktTxPowerLevelToDBm(frame.TxPower); ok {
+			return referenceFromTxPower(dbm), true
+		}
+	case *KontaktShuffledAdvertisement:
+		if dbm, ok := KontaktTxPowerLevelToDBm(frame.TxPower); ok {
+			return referenceFromTxPower(dbm), true
+		}
+	case *KontaktLocationAdvertisement:
+		return referenceFromTxPower(frame.TxPower), true
+	case *KontaktIOScanResponse:
+		if frame.HasTxPower {
+			return referenceFromTxPower(frame.TxPower), true
+		}
+	}
+	return 0, false
+}
+
+// referenceFromTxPower converts transmit power to RSSI at 1m, clamped to int8 range
+func referenceFromTxPower(txPower int8) int8 {
+	reference := int(txPower) - eddystoneReferenceLoss
+	if reference < math.MinInt8 {
+		return math.MinInt8
+	}
+	return int8(reference)
+}
+
+// DistanceModel estimates distance in meters from measured RSSI and RSSI expected at 1m.
+// Negative value is returned when distance can't be estimated.
+type DistanceModel interface {
+	Distance(rssi float64, referenceRSSI int8) float64
+}
+
+// PathLossModel is a log-distance path loss model: rssi = referenceRSSI - 10 * Exponent * log10(distance).
+// Exponent is 2 in free space and usually between 2 and 4 indoors.
+type PathLossModel struct {
+	Exponent float64
+}
+
+func (m PathLossModel) Distance(rssi float64, referenceRSSI int8) float64 {
+	if rssi == 0 || m.Exponent == 0 {
+		return -1
+	}
+	return math.Pow(10, (float64(referenceRSSI)-rssi)/(10*m.Exponent))
+}
+
+// CurveFitModel is a model used by Android Beacon Library: distance = A * (rssi/referenceRSSI)^B + C,
+// with coefficients fitted to measurements of a given device
+type CurveFitModel struct {
+	A float64
+	B float64
+	C float64
+}
+
+func (m CurveFitModel) Distance(rssi float64, referenceRSSI int8) float64 {
+	if rssi == 0 || referenceRSSI == 0 {
+		return -1
+	}
+	ratio := rssi / float64(referenceRSSI)
+	if ratio < 1 {
+		return math.Pow(ratio, 10)
+	}
+	return m.A*math.Pow(ratio, m.B) + m.C
+}
+
+var (
+	// DefaultPathLossModel is a free space path loss model
+	DefaultPathLossModel = PathLossModel{Exponent: 2}
+	// DefaultCurveFitModel uses Android Beacon Library coefficients fitted for Nexus 5, the defaults of its
+	// CurveFittedDistanceCalculator
+	DefaultCurveFitModel = CurveFitModel{A: 0.42093, B: 6.9476, C: 0.54992}
+)
+
+// DistanceModelSelector returns distance model calibrated for device model
+type DistanceModelSelector func(model DeviceModel) DistanceModel
+
+var (
+	// PathLossModels selects DefaultPathLossModel for every device model
+	PathLossModels DistanceModelSelector = func(DeviceModel) DistanceModel {
+		return DefaultPathLossModel
+	}
+	// CurveFitModels selects DefaultCurveFitModel for every device model
+	CurveFitModels DistanceModelSelector = func(DeviceModel) DistanceModel {
+		return DefaultCurveFitModel
+	}
+)
+
+// ModelDistances returns selector of distance models calibrated by the caller for device models, e.g.
+// a higher path loss exponent for wearables attenuated by human body. Fallback is selected for models
+// missing in the map.
+func ModelDistances(models map[DeviceModel]DistanceModel, fallback DistanceModel) DistanceModelSelector {
+	return func(model DeviceModel) DistanceModel {
+		if m, ok := models[model]; ok {
+			return m
+		}
+		return fallback
+	}
+}
+
+// EstimateDistance estimates distance to beacon which sent parsed frame with given RSSI.
+// False is returned when frame carries no calibration.
+func EstimateDistance(model DistanceModel, parsed interface{}, rssi float64) (float64, bool) {
+	reference, ok := ReferenceRSSI(parsed)
+	if !ok {
+		return 0, false
+	}
+	distance := model.Distance(rssi, reference)
+	return distance, distance >= 0
+}
+
+// EstimateModelDistance estimates distance like EstimateDistance, with distance model selected for device
+// model of the beacon. Model is taken from the frame when it carries one (see FrameModel), deviceModel is
+// used otherwise, e.g. for iBeacon frames of a beacon which model is known from its other frames.
+func EstimateModelDistance(selector DistanceModelSelector, parsed interface{}, deviceModel DeviceModel, rssi float64) (float64, bool) {
+	if model := FrameModel(parsed); model != UnknownModel {
+		deviceModel = model
+	}
+	return EstimateDistance(selector(deviceModel), parsed, rssi)
+}
+
+// Proximity is a coarse distance zone, like CoreLocation's CLProximity
+type Proximity int
+
+const (
+	// ProximityUnknown - distance couldn't be estimated
+	ProximityUnknown Proximity = iota
+	// ProximityImmediate - beacon is closer than 0.5m
+	ProximityImmediate
+	// ProximityNear - beacon is closer than 3m
+	ProximityNear
+	// ProximityFar - beacon is further than 3m
+	ProximityFar
+)
+
+var proximityNames = map[Proximity]string{
+	ProximityUnknown:   "unknown",
+	ProximityImmediate: "immediate",
+	ProximityNear:      "near",
+	ProximityFar:       "far",
+}
+
+func (p Proximity) String() string {
+	if name, ok := proximityNames[p]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ProximityFromDistance assigns distance in meters to proximity zone
+func ProximityFromDistance(distance float64) Proximity {
+	switch {
+	case distance < 0 || math.IsNaN(distance):
+		return ProximityUnknown
+	case distance < 0.5:
+		return ProximityImmediate
+	case distance < 3:
+		return ProximityNear
+	}
+	return ProximityFar
+}
@@ -0,0 +1,125 @@
+package kontaktparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKontaktTxPowerLevelToDBm(t *testing.T) {
+	dbm, ok := KontaktTxPowerLevelToDBm(3)
+	assert.True(t, ok)
+	assert.Equal(t, int8(-12), dbm)
+
+	_, ok = KontaktTxPowerLevelToDBm(8)
+	assert.False(t, ok)
+	_, ok = KontaktTxPowerLevelToDBm(-1)
+	assert.False(t, ok)
+}
+
+func TestReferenceRSSI(t *testing.T) {
+	reference, ok := ReferenceRSSI(&IBeaconAdvertisement{CalibratedRssi: -77})
+	assert.True(t, ok)
+	assert.Equal(t, int8(-77), reference)
+
+	reference, ok = ReferenceRSSI(&EddystoneUIDPacket{TxPower0M: -20})
+	assert.True(t, ok)
+	assert.Equal(t, int8(-61), reference)
+
+	reference, ok = ReferenceRSSI(&KontaktPlainAdvertisement{TxPower: 4})
+	assert.True(t, ok)
+	assert.Equal(t, int8(-49), reference)
+
+	reference, ok = ReferenceRSSI(&KontaktLocationAdvertisement{TxPower: -12})
+	assert.True(t, ok)
+	assert.Equal(t, int8(-53), reference)
+
+	_, ok = ReferenceRSSI(&KontaktTelemetryAdvertisement{})
+	assert.False(t, ok)
+	_, ok = ReferenceRSSI(&KontaktIOScanResponse{})
+	assert.False(t, ok)
+}
+
+func TestReferenceRSSIExtremeTxPower(t *testing.T) {
+	reference, ok := ReferenceRSSI(&EddystoneUIDPacket{TxPower0M: -100})
+	assert.True(t, ok)
+	assert.Equal(t, int8(-128), reference)
+
+	reference, ok = ReferenceRSSI(&KontaktLocationAdvertisement{TxPower: -128})
+	assert.True(t, ok)
+	assert.Equal(t, int8(-128), reference)
+
+	reference, ok = ReferenceRSSI(&EddystoneURLPacket{TxPower0M: 127})
+	assert.True(t, ok)
+	assert.Equal(t, int8(86), reference)
+}
+
+func TestPathLossModel(t *testing.T) {
+	assert.InDelta(t, 1, DefaultPathLossModel.Distance(-59, -59), 0.0001)
+	assert.InDelta(t, 10, DefaultPathLossModel.Distance(-79, -59), 0.0001)
+	assert.InDelta(t, 10, PathLossModel{Exponent: 3}.Distance(-89, -59), 0.0001)
+	assert.Equal(t, float64(-1), DefaultPathLossModel.Distance(0, -59))
+}
+
+func TestCurveFitModel(t *testing.T) {
+	assert.InDelta(t, 0.97085, DefaultCurveFitModel.Distance(-59, -59), 0.0001)
+	assert.InDelta(t, 0.34868, DefaultCurveFitModel.Distance(-53.1, -59), 0.0001)
+	assert.InDelta(t, 4.04089, DefaultCurveFitModel.Distance(-80, -59), 0.0001)
+	assert.Equal(t, float64(-1), DefaultCurveFitModel.Distance(0, -59))
+}
+
+func TestEstimateDistance(t *testing.T) {
+	distance, ok := EstimateDistance(DefaultPathLossModel, &IBeaconAdvertisement{CalibratedRssi: -59}, -79)
+	assert.True(t, ok)
+	assert.InDelta(t, 10, distance, 0.0001)
+
+	_, ok = EstimateDistance(DefaultPathLossModel, &KontaktTelemetryAdvertisement{}, -79)
+	assert.False(t, ok)
+	_, ok = EstimateDistance(DefaultPathLossModel, &IBeaconAdvertisement{CalibratedRssi: -59}, 0)
+	assert.False(t, ok)
+}
+
+func TestModelDistances(t *testing.T) {
+	badge := PathLossModel{Exponent: 2.5}
+	selector := ModelDistances(map[DeviceModel]DistanceModel{Badge: badge}, DefaultPathLossModel)
+	assert.Equal(t, badge, selector(Badge))
+	assert.Equal(t, DefaultPathLossModel, selector(SmartBeacon))
+	assert.Equal(t, DefaultPathLossModel, PathLossModels(Badge))
+	assert.Equal(t, DefaultCurveFitModel, CurveFitModels(Badge))
+}
+
+func TestEstimateModelDistance(t *testing.T) {
+	models := ModelDistances(map[DeviceModel]DistanceModel{
+		Badge: PathLossModel{Exponent: 2.5},
+	}, DefaultPathLossModel)
+
+	// Badge model is sent in the frame
+	location := &KontaktLocationAdvertisement{TxPower: -18, DeviceModel: uint8(Badge)}
+	distance, ok := EstimateModelDistance(models, location, SmartBeacon, -84)
+	assert.True(t, ok)
+	assert.InDelta(t, 10, distance, 0.0001)
+
+	// iBeacon doesn't carry model, the one known from other frames is used
+	ibeacon := &IBeaconAdvertisement{CalibratedRssi: -59}
+	distance, ok = EstimateModelDistance(models, ibeacon, Badge, -84)
+	assert.True(t, ok)
+	assert.InDelta(t, 10, distance, 0.0001)
+	distance, ok = EstimateModelDistance(models, ibeacon, UnknownModel, -79)
+	assert.True(t, ok)
+	assert.InDelta(t, 10, distance, 0.0001)
+
+	distance, ok = EstimateModelDistance(CurveFitModels, ibeacon, Badge, -80)
+	assert.True(t, ok)
+	assert.InDelta(t, DefaultCurveFitModel.Distance(-80, -59), distance, 0.0001)
+
+	_, ok = EstimateModelDistance(CurveFitModels, &KontaktTelemetryAdvertisement{}, Badge, -80)
+	assert.False(t, ok)
+}
+
+func TestProximityFromDistance(t *testing.T) {
+	assert.Equal(t, ProximityUnknown, ProximityFromDistance(-1))
+	assert.Equal(t, ProximityImmediate, ProximityFromDistance(0.3))
+	assert.Equal(t, ProximityNear, ProximityFromDistance(1.5))
+	assert.Equal(t, ProximityFar, ProximityFromDistance(12))
+	assert.Equal(t, "near", ProximityNear.String())
+}
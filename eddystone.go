@@ -1,5 +1,18 @@
 package kontaktparser
 
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+var (
+	ErrBeaconRebooted = errors.New("beacon was rebooted between tlm frames")
+)
+
+// eddystoneTLMTemperatureNotSupported is 0x8000 temperature value decoded as 8.8 fixed point number
+const eddystoneTLMTemperatureNotSupported = -128.0
+
 type EddystoneUIDPacket struct {
 	TxPower0M  int8
 	Namespace  []byte
@@ -18,6 +31,46 @@ type EddystonePlainTLMPacket struct {
 	TimeSincePowerOn   float64
 }
 
+// HasBatteryVoltage reports whether beacon measures its battery voltage. Beacons without battery
+// (e.g. USB powered) send zero.
+func (p *EddystonePlainTLMPacket) HasBatteryVoltage() bool {
+	return p.BatteryVoltage != 0
+}
+
+// HasTemperature reports whether beacon has temperature sensor. Beacons without one send 0x8000.
+func (p *EddystonePlainTLMPacket) HasTemperature() bool {
+	return p.Temperature != eddystoneTLMTemperatureNotSupported
+}
+
+// Uptime returns time since beacon power on or reboot with 0.1s resolution
+func (p *EddystonePlainTLMPacket) Uptime() time.Duration {
+	return time.Duration(math.Round(p.TimeSincePowerOn*10)) * 100 * time.Millisecond
+}
+
+// BootTime estimates when beacon was powered on, based on time the frame was received
+func (p *EddystonePlainTLMPacket) BootTime(received time.Time) time.Time {
+	return received.Add(-p.Uptime())
+}
+
+// RebootedSince reports whether beacon was restarted after sending previous frame,
+// which is detected by uptime or advertisement counter going backwards
+func (p *EddystonePlainTLMPacket) RebootedSince(previous *EddystonePlainTLMPacket) bool {
+	return p.TimeSincePowerOn < previous.TimeSincePowerOn || p.AdvertisementCount < previous.AdvertisementCount
+}
+
+// AdvertisingRate returns number of advertisements per second sent by beacon between previous
+// and this frame. ErrBeaconRebooted is returned when counters were reset in the meantime.
+func (p *EddystonePlainTLMPacket) AdvertisingRate(previous *EddystonePlainTLMPacket) (float64, error) {
+	if p.RebootedSince(previous) {
+		return 0, ErrBeaconRebooted
+	}
+	elapsed := p.Uptime() - previous.Uptime()
+	if elapsed == 0 {
+		return 0, nil
+	}
+	return float64(p.AdvertisementCount-previous.AdvertisementCount) / elapsed.Seconds(), nil
+}
+
 type EddystoneEncryptedTLMPacket struct {
 	Telemetry []byte
 	Salt      []byte
@@ -28,3 +81,10 @@ type EddystoneEIDPacket struct {
 	TxPower0M int8
 	EID       []byte
 }
+
+// EddystoneUnknownPacket holds Eddystone frame which couldn't be decoded - either frame type
+// is not known or it's a TLM frame of unsupported version (first byte of Payload)
+type EddystoneUnknownPacket struct {
+	FrameType byte
+	Payload   []byte
+}
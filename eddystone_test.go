@@ -0,0 +1,55 @@
+package kontaktparser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEddystoneTLMSupportedValues(t *testing.T) {
+	tlm := EddystonePlainTLMPacket{BatteryVoltage: 3000, Temperature: 21.5}
+	assert.True(t, tlm.HasBatteryVoltage())
+	assert.True(t, tlm.HasTemperature())
+}
+
+func TestEddystoneTLMNotSupportedValues(t *testing.T) {
+	tlm := EddystonePlainTLMPacket{BatteryVoltage: 0, Temperature: -128}
+	assert.False(t, tlm.HasBatteryVoltage())
+	assert.False(t, tlm.HasTemperature())
+}
+
+func TestEddystoneTLMUptime(t *testing.T) {
+	tlm := EddystonePlainTLMPacket{TimeSincePowerOn: 6553.6}
+	assert.Equal(t, 6553*time.Second+600*time.Millisecond, tlm.Uptime())
+
+	received := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2020, 1, 1, 10, 10, 46, 400000000, time.UTC), tlm.BootTime(received))
+}
+
+func TestEddystoneTLMAdvertisingRate(t *testing.T) {
+	previous := EddystonePlainTLMPacket{AdvertisementCount: 1000, TimeSincePowerOn: 100}
+	current := EddystonePlainTLMPacket{AdvertisementCount: 1500, TimeSincePowerOn: 150}
+
+	assert.False(t, current.RebootedSince(&previous))
+	rate, err := current.AdvertisingRate(&previous)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(10), rate)
+}
+
+func TestEddystoneTLMAdvertisingRateSameFrame(t *testing.T) {
+	tlm := EddystonePlainTLMPacket{AdvertisementCount: 1000, TimeSincePowerOn: 100}
+
+	rate, err := tlm.AdvertisingRate(&tlm)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(0), rate)
+}
+
+func TestEddystoneTLMReboot(t *testing.T) {
+	previous := EddystonePlainTLMPacket{AdvertisementCount: 1000, TimeSincePowerOn: 100}
+	current := EddystonePlainTLMPacket{AdvertisementCount: 20, TimeSincePowerOn: 2}
+
+	assert.True(t, current.RebootedSince(&previous))
+	_, err := current.AdvertisingRate(&previous)
+	assert.Equal(t, ErrBeaconRebooted, err)
+}
@@ -0,0 +1,143 @@
+package kontaktparser
+
+import (
+	"encoding/hex"
+	"errors"
+	"strconv"
+)
+
+var (
+	ErrNotExportable     = errors.New("record has no parsed frame")
+	ErrFrameTypeMismatch = errors.New("record frame type doesn't match writer frame type")
+)
+
+// exportColumns are value columns of every frame type, shared by CSV and InfluxDB line protocol writers.
+// Columns are part of the export format and must only be appended to.
+var exportColumns = map[DetectedType][]string{
+	IBeacon:             {"calibrated_rssi", "proximity_uuid", "major", "minor"},
+	KontaktScanResponse: {"name", "tx_power", "firmware", "battery_level", "unique_id"},
+	KontaktPlain:        {"device_model", "model_name", "firmware", "battery_level", "tx_power", "unique_id"},
+	KontaktShuffled: {"device_model", "model_name", "firmware", "battery_level", "tx_power",
+		"eddystone_namespace", "eddystone_instance_id"},
+	KontaktTelemetry: {"battery_level", "utc_time", "sensitivity", "acceleration_x", "acceleration_y",
+		"acceleration_z", "seconds_since_double_tap", "seconds_since_threshold", "light_level", "temperature",
+		"click_id", "seconds_since_click", "humidity", "movement_counter"},
+	KontaktLocation:  {"tx_power", "ble_channel", "device_model", "model_name", "shuffling_enabled", "unique_id"},
+	EddystoneUID:     {"tx_power_0m", "namespace", "instance_id"},
+	EddystoneURL:     {"tx_power_0m", "url"},
+	EddystoneTLM:     {"battery_voltage", "temperature", "advertisement_count", "time_since_power_on"},
+	EddystoneETLM:    {"telemetry", "salt", "mic"},
+	EddystoneEID:     {"tx_power_0m", "eid"},
+	EddystoneUnknown: {"frame_type", "payload"},
+}
+
+// exportValues returns values of parsed frame by column name. Values are int64, float64, string or bool,
+// columns without value (e.g. sensors not reported by the beacon) are missing.
+func exportValues(parsed interface{}) map[string]interface{} {
+	values := make(map[string]interface{})
+	switch frame := parsed.(type) {
+	case *IBeaconAdvertisement:
+		values["calibrated_rssi"] = int64(frame.CalibratedRssi)
+		values["proximity_uuid"] = frame.ProximityUUID.String()
+		values["major"] = int64(frame.Major)
+		values["minor"] = int64(frame.Minor)
+	case *KontaktIOScanResponse:
+		if frame.HasName {
+			values["name"] = frame.Name
+		}
+		if frame.HasTxPower {
+			values["tx_power"] = int64(frame.TxPower)
+		}
+		if frame.HasIdentifier {
+			values["firmware"] = frame.Firmware
+			values["battery_level"] = int64(frame.BatteryLevel)
+			values["unique_id"] = frame.UniqueID
+		}
+	case *KontaktPlainAdvertisement:
+		values["device_model"] = int64(frame.DeviceModel)
+		values["model_name"] = frame.Model().String()
+		values["firmware"] = frame.FirmwareVersion().String()
+		values["battery_level"] = int64(frame.BatteryLevel)
+		values["tx_power"] = int64(frame.TxPower)
+		values["unique_id"] = frame.UniqueID
+	case *KontaktShuffledAdvertisement:
+		values["device_model"] = int64(frame.DeviceModel)
+		values["model_name"] = frame.Model().String()
+		values["firmware"] = frame.FirmwareVersion().String()
+		values["battery_level"] = int64(frame.BatteryLevel)
+		values["tx_power"] = int64(frame.TxPower)
+		values["eddystone_namespace"] = hex.EncodeToString(frame.EddystoneNamespace)
+		values["eddystone_instance_id"] = hex.EncodeToString(frame.EddystoneInstanceID)
+	case *KontaktTelemetryAdvertisement:
+		exportTelemetry(frame, values)
+	case *KontaktLocationAdvertisement:
+		values["tx_power"] = int64(frame.TxPower)
+		values["ble_channel"] = int64(frame.BleChannel)
+		values["device_model"] = int64(frame.DeviceModel)
+		values["model_name"] = frame.Model().String()
+		values["shuffling_enabled"] = frame.LocationFlags().ShufflingEnabled()
+		values["unique_id"] = frame.UniqueID
+	case *EddystoneUIDPacket:
+		values["tx_power_0m"] = int64(frame.TxPower0M)
+		values["namespace"] = hex.EncodeToString(frame.Namespace)
+		values["instance_id"] = hex.EncodeToString(frame.InstanceId)
+	case *EddystoneURLPacket:
+		values["tx_power_0m"] = int64(frame.TxPower0M)
+		values["url"] = frame.URL
+	case *EddystonePlainTLMPacket:
+		if frame.HasBatteryVoltage() {
+			values["battery_voltage"] = int64(frame.BatteryVoltage)
+		}
+		if frame.HasTemperature() {
+			values["temperature"] = frame.Temperature
+		}
+		values["advertisement_count"] = int64(frame.AdvertisementCount)
+		values["time_since_power_on"] = frame.TimeSincePowerOn
+	case *EddystoneEncryptedTLMPacket:
+		values["telemetry"] = hex.EncodeToString(frame.Telemetry)
+		values["salt"] = hex.EncodeToString(frame.Salt)
+		values["mic"] = hex.EncodeToString(frame.MIC)
+	case *EddystoneEIDPacket:
+		values["tx_power_0m"] = int64(frame.TxPower0M)
+		values["eid"] = hex.EncodeToString(frame.EID)
+	case *EddystoneUnknownPacket:
+		values["frame_type"] = int64(frame.FrameType)
+		values["payload"] = hex.EncodeToString(frame.Payload)
+	}
+	return values
+}
+
+// exportTelemetry stores decoded telemetry fields, fields which couldn't be decoded are skipped
+func exportTelemetry(frame *KontaktTelemetryAdvertisement, values map[string]interface{}) {
+	for name, value := range DecodeTelemetryValues(frame) {
+		values[name] = value
+	}
+}
+
+// exportFrameType returns frame type of record, ErrNotExportable is returned for records which failed parsing
+func exportFrameType(record ParsedRecord) (DetectedType, error) {
+	if record.Err != nil || record.Parsed == nil {
+		return Unknown, ErrNotExportable
+	}
+	typ := FrameType(record.Parsed)
+	if _, ok := exportColumns[typ]; !ok {
+		return Unknown, ErrNotExportable
+	}
+	return typ, nil
+}
+
+// exportID returns identity of record's beacon, falling back to MAC address for frames without identity
+func exportID(record ParsedRecord) string {
+	if id, ok := FrameIdentity(record.Parsed); ok {
+		return id
+	}
+	if record.MAC != "" {
+		return macID(record.MAC)
+	}
+	return ""
+}
+
+// formatFloat formats float without exponent, which isn't accepted by every consumer
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,62 @@
+package kontaktparser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func exportRecord(parser Parser) ParsedRecord {
+	return ParsedRecord{
+		ScanRecord: ScanRecord{
+			ReceiverID: "gw 1",
+			MAC:        "aa:bb:cc:dd:ee:ff",
+			RSSI:       -60,
+			Received:   time.Unix(1600000000, 5),
+		},
+		DetectedType: parser.DetectedType,
+		Flags:        parser.Flags,
+		Parsed:       parser.Parsed,
+	}
+}
+
+func TestExportValuesMatchColumns(t *testing.T) {
+	for _, parser := range allFixtures(t) {
+		columns, ok := exportColumns[parser.DetectedType]
+		assert.True(t, ok, parser.DetectedType.String())
+		values := exportValues(parser.Parsed)
+		assert.NotEmpty(t, values, parser.DetectedType.String())
+		for name := range values {
+			assert.Contains(t, columns, name, parser.DetectedType.String())
+		}
+	}
+}
+
+func TestExportTelemetryColumns(t *testing.T) {
+	for _, field := range validFields() {
+		decoded, _ := DecodeTelemetry(field)
+		for name := range decoded.(FieldValuer).Values() {
+			assert.Contains(t, exportColumns[KontaktTelemetry], name, field.PID.String())
+		}
+	}
+}
+
+func TestExportTelemetry(t *testing.T) {
+	parser := parseFixture(t, "0201060E166AFE03020A4B03131A8002125A", false)
+	assert.Equal(t, map[string]interface{}{
+		"light_level": int64(75),
+		"temperature": 26.5,
+		"humidity":    int64(90),
+	}, exportValues(parser.Parsed))
+}
+
+func TestExportFrameType(t *testing.T) {
+	_, err := exportFrameType(ParsedRecord{Err: ErrInvalidLength})
+	assert.Equal(t, ErrNotExportable, err)
+
+	parser := parseFixture(t, frameFixtures[KontaktPlain], false)
+	typ, err := exportFrameType(exportRecord(parser))
+	assert.Nil(t, err)
+	assert.Equal(t, KontaktPlain, typ)
+}
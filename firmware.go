@@ -0,0 +1,78 @@
+package kontaktparser
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidFirmwareVersion = errors.New("invalid firmware version")
+)
+
+// FirmwareVersion is a version of beacon's firmware in major.minor form
+type FirmwareVersion struct {
+	Major uint8
+	Minor uint8
+}
+
+// ParseFirmwareVersion parses version in "major.minor" form, as found in KontaktIOScanResponse.Firmware
+func ParseFirmwareVersion(version string) (FirmwareVersion, error) {
+	parts := strings.Split(version, ".")
+	if len(parts) != 2 {
+		return FirmwareVersion{}, ErrInvalidFirmwareVersion
+	}
+	major, err := strconv.ParseUint(parts[0], 10, 8)
+	if err != nil {
+		return FirmwareVersion{}, ErrInvalidFirmwareVersion
+	}
+	minor, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil {
+		return FirmwareVersion{}, ErrInvalidFirmwareVersion
+	}
+	return FirmwareVersion{Major: uint8(major), Minor: uint8(minor)}, nil
+}
+
+// Compare returns -1 if v is older than other, 1 if it's newer and 0 if versions are equal
+func (v FirmwareVersion) Compare(other FirmwareVersion) int {
+	switch {
+	case v.Major < other.Major:
+		return -1
+	case v.Major > other.Major:
+		return 1
+	case v.Minor < other.Minor:
+		return -1
+	case v.Minor > other.Minor:
+		return 1
+	}
+	return 0
+}
+
+// Less reports whether v is older than other
+func (v FirmwareVersion) Less(other FirmwareVersion) bool {
+	return v.Compare(other) < 0
+}
+
+func (v FirmwareVersion) String() string {
+	return fmt.Sprintf("%v.%v", v.Major, v.Minor)
+}
+
+// FirmwareVersion returns firmware version sent in the advertisement
+func (a *KontaktPlainAdvertisement) FirmwareVersion() FirmwareVersion {
+	return FirmwareVersion{Major: a.FirmwareMajor, Minor: a.FirmwareMinor}
+}
+
+// FirmwareVersion returns firmware version sent in the advertisement
+func (a *KontaktShuffledAdvertisement) FirmwareVersion() FirmwareVersion {
+	return FirmwareVersion{Major: a.FirmwareMajor, Minor: a.FirmwareMinor}
+}
+
+// FirmwareVersion returns parsed Firmware field, ErrInvalidFirmwareVersion is returned when
+// scan response didn't contain identifier section
+func (r *KontaktIOScanResponse) FirmwareVersion() (FirmwareVersion, error) {
+	if !r.HasIdentifier {
+		return FirmwareVersion{}, ErrInvalidFirmwareVersion
+	}
+	return ParseFirmwareVersion(r.Firmware)
+}
@@ -0,0 +1,45 @@
+package kontaktparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFirmwareVersion(t *testing.T) {
+	version, err := ParseFirmwareVersion("4.12")
+	assert.Nil(t, err)
+	assert.Equal(t, FirmwareVersion{Major: 4, Minor: 12}, version)
+	assert.Equal(t, "4.12", version.String())
+}
+
+func TestParseFirmwareVersionInvalid(t *testing.T) {
+	for _, version := range []string{"", "4", "4.2.1", "a.1", "1.256", "-1.0"} {
+		_, err := ParseFirmwareVersion(version)
+		assert.Equal(t, ErrInvalidFirmwareVersion, err, version)
+	}
+}
+
+func TestFirmwareVersionCompare(t *testing.T) {
+	assert.Equal(t, 0, FirmwareVersion{4, 2}.Compare(FirmwareVersion{4, 2}))
+	assert.Equal(t, -1, FirmwareVersion{4, 2}.Compare(FirmwareVersion{4, 10}))
+	assert.Equal(t, -1, FirmwareVersion{3, 20}.Compare(FirmwareVersion{4, 0}))
+	assert.Equal(t, 1, FirmwareVersion{4, 10}.Compare(FirmwareVersion{4, 2}))
+	assert.True(t, FirmwareVersion{1, 0}.Less(FirmwareVersion{1, 1}))
+	assert.False(t, FirmwareVersion{1, 1}.Less(FirmwareVersion{1, 1}))
+}
+
+func TestScanResponseFirmwareVersion(t *testing.T) {
+	sr := KontaktIOScanResponse{Firmware: "4.2", HasIdentifier: true}
+	version, err := sr.FirmwareVersion()
+	assert.Nil(t, err)
+	assert.Equal(t, FirmwareVersion{4, 2}, version)
+
+	_, err = (&KontaktIOScanResponse{}).FirmwareVersion()
+	assert.Equal(t, ErrInvalidFirmwareVersion, err)
+}
+
+func TestAdvertisementFirmwareVersion(t *testing.T) {
+	plain := KontaktPlainAdvertisement{FirmwareMajor: 1, FirmwareMinor: 15}
+	assert.Equal(t, FirmwareVersion{1, 15}, plain.FirmwareVersion())
+}
@@ -0,0 +1,486 @@
+package kontaktparser
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// KontaktIOScanResponseView is KontaktIOScanResponse with text fields referencing parsed data
+type KontaktIOScanResponseView struct {
+	Name          []byte
+	HasName       bool
+	TxPower       int8
+	HasTxPower    bool
+	FirmwareMajor uint8
+	FirmwareMinor uint8
+	BatteryLevel  uint8
+	UniqueID      []byte
+	HasIdentifier bool
+}
+
+// KontaktPlainAdvertisementView is KontaktPlainAdvertisement with Unique ID referencing parsed data
+type KontaktPlainAdvertisementView struct {
+	DeviceModel   uint8
+	FirmwareMajor uint8
+	FirmwareMinor uint8
+	BatteryLevel  uint8
+	TxPower       int8
+	UniqueID      []byte
+}
+
+// KontaktLocationAdvertisementView is KontaktLocationAdvertisement with Unique ID referencing parsed data
+type KontaktLocationAdvertisementView struct {
+	TxPower     int8
+	BleChannel  uint8
+	DeviceModel uint8
+	Flags       uint8
+	UniqueID    []byte
+}
+
+// EddystoneURLPacketView is EddystoneURLPacket with URL decoded into buffer reused by Frame
+type EddystoneURLPacketView struct {
+	TxPower0M int8
+	URL       []byte
+}
+
+// Frame is a result of allocation free parsing. Only the member matching DetectedType holds data of
+// the last parsed packet, other members are left from previous packets.
+//
+// Byte slices reference parsed data, except for EddystoneURL.URL and Telemetry.Fields which are buffers
+// reused by subsequent calls. Both are allocated only when capacity left by previous call isn't enough,
+// so a Frame reused for many packets doesn't allocate at all. Frame has to be copied with Parsed before
+// parsed data is modified or the Frame is reused, if the result has to be kept.
+type Frame struct {
+	DetectedType DetectedType
+	Flags        byte
+	// HasFlags is false when advertisement had no flags section
+	HasFlags bool
+
+	IBeacon          IBeaconAdvertisement
+	ScanResponse     KontaktIOScanResponseView
+	Plain            KontaktPlainAdvertisementView
+	Shuffled         KontaktShuffledAdvertisement
+	Telemetry        KontaktTelemetryAdvertisement
+	Location         KontaktLocationAdvertisementView
+	EddystoneUID     EddystoneUIDPacket
+	EddystoneURL     EddystoneURLPacketView
+	EddystoneTLM     EddystonePlainTLMPacket
+	EddystoneETLM    EddystoneEncryptedTLMPacket
+	EddystoneEID     EddystoneEIDPacket
+	EddystoneUnknown EddystoneUnknownPacket
+}
+
+// nextSection splits data into type and content of the first AD structure and data following it
+func nextSection(data []byte) (byte, []byte, []byte, error) {
+	if len(data) < 2 {
+		return 0, nil, nil, io.EOF
+	}
+	length := int(data[0])
+	typ := data[1]
+	if length == 0 || len(data) < length+1 {
+		return typ, nil, nil, io.EOF
+	}
+	return typ, data[2 : length+1], data[length+1:], nil
+}
+
+// ParseScanResponse parses scan response into f, same way as Parser.ParseScanResponse does,
+// but without allocating
+func (f *Frame) ParseScanResponse(data []byte) error {
+	f.DetectedType = Unknown
+	f.Flags = 0
+	f.HasFlags = false
+	scanResponse := KontaktIOScanResponseView{}
+	for len(data) > 0 {
+		typ, section, rest, err := nextSection(data)
+		if err != nil {
+			return err
+		}
+		data = rest
+		switch typ {
+		case completeNameType:
+			scanResponse.Name = section
+			scanResponse.HasName = true
+		case txPowerType:
+			if len(section) < 1 {
+				return io.EOF
+			}
+			scanResponse.TxPower = int8(section[0])
+			scanResponse.HasTxPower = true
+		case serviceDataDataType:
+			if len(section) != 9 || !bytes.Equal(section[0:2], kontaktScanResponseUUID) {
+				continue
+			}
+			scanResponse.UniqueID = section[2:6]
+			scanResponse.FirmwareMajor = uint8(section[6])
+			scanResponse.FirmwareMinor = uint8(section[7])
+			scanResponse.BatteryLevel = uint8(section[8])
+			scanResponse.HasIdentifier = true
+		}
+	}
+	if scanResponse.HasName || scanResponse.HasTxPower || scanResponse.HasIdentifier {
+		f.ScanResponse = scanResponse
+		f.DetectedType = KontaktScanResponse
+	}
+	return nil
+}
+
+// ParseAdvertisement parses advertisement into f, same way as Parser.ParseAdvertisement does,
+// but without allocating
+func (f *Frame) ParseAdvertisement(adv []byte) error {
+	f.DetectedType = Unknown
+	f.Flags = 0
+	f.HasFlags = false
+	for len(adv) > 0 {
+		typ, section, rest, err := nextSection(adv)
+		if err != nil {
+			return err
+		}
+		adv = rest
+		switch typ {
+		case flagsDataType:
+			if len(section) < 1 {
+				return io.EOF
+			}
+			f.Flags = section[0]
+			f.HasFlags = true
+		case manufacturerDataType:
+			if len(section) != ibeaconLength {
+				continue
+			}
+			if err = f.parseIBeacon(section); err == ErrInvalidPreamble {
+				continue
+			} else if err != nil {
+				return err
+			}
+		case serviceDataDataType:
+			if len(section) < 2 {
+				return io.EOF
+			}
+			uuid := section[0:2]
+			if bytes.Equal(uuid, kontaktUUID) {
+				if err := f.parseKontaktAdv(section); err != nil {
+					return err
+				}
+			} else if bytes.Equal(uuid, eddystoneUUID) {
+				if err := f.parseEddystone(section); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (f *Frame) parseIBeacon(section []byte) error {
+	if !bytes.Equal(section[0:4], ibeaconManufacturerConstData) {
+		return ErrInvalidPreamble
+	}
+	f.IBeacon.CalibratedRssi = int8(section[24])
+	copy(f.IBeacon.ProximityUUID[:], section[4:20])
+	f.IBeacon.Major = binary.LittleEndian.Uint16(section[20:22])
+	f.IBeacon.Minor = binary.LittleEndian.Uint16(section[22:24])
+	f.DetectedType = IBeacon
+	return nil
+}
+
+func (f *Frame) parseKontaktAdv(section []byte) error {
+	if len(section) < 3 {
+		return io.EOF
+	}
+	switch section[2] {
+	case 0x01:
+		f.parseKontaktShuffled(section)
+	case 0x02:
+		f.parseKontaktPlain(section)
+	case 0x03:
+		return f.parseKontaktTelemetry(section)
+	case 0x05:
+		f.parseKontaktLocation(section)
+	default:
+		return ErrInvalidKontaktPayloadIdentifier
+	}
+	return nil
+}
+
+func (f *Frame) parseKontaktPlain(section []byte) {
+	if len(section) < 9 {
+		return
+	}
+	f.Plain = KontaktPlainAdvertisementView{
+		DeviceModel:   uint8(section[3]),
+		FirmwareMajor: uint8(section[4]),
+		FirmwareMinor: uint8(section[5]),
+		BatteryLevel:  uint8(section[6]),
+		TxPower:       int8(section[7]),
+		UniqueID:      section[8:],
+	}
+	f.DetectedType = KontaktPlain
+}
+
+func (f *Frame) parseKontaktShuffled(section []byte) {
+	if len(section) != 24 {
+		return
+	}
+	f.Shuffled = KontaktShuffledAdvertisement{
+		DeviceModel:         uint8(section[3]),
+		FirmwareMajor:       uint8(section[4]),
+		FirmwareMinor:       uint8(section[5]),
+		BatteryLevel:        uint8(section[6]),
+		TxPower:             int8(section[7]),
+		EddystoneNamespace:  section[8:18],
+		EddystoneInstanceID: section[18:24],
+	}
+	f.DetectedType = KontaktShuffled
+}
+
+func (f *Frame) parseKontaktTelemetry(section []byte) error {
+	// fields are validated first, so that telemetry parsed from previous section isn't overwritten
+	// by the invalid one
+	for data := section[3:]; len(data) > 0; {
+		length := int(data[0])
+		if length == 0 || len(data) < length+1 {
+			return io.EOF
+		}
+		data = data[length+1:]
+	}
+	fields := f.Telemetry.Fields[:0]
+	for data := section[3:]; len(data) > 0; {
+		length := int(data[0])
+		fields = append(fields, KontaktTelemetryValue{
+			PID:   TelemetryPID(data[1]),
+			Value: data[2 : length+1],
+		})
+		data = data[length+1:]
+	}
+	f.Telemetry.Fields = fields
+	f.DetectedType = KontaktTelemetry
+	return nil
+}
+
+func (f *Frame) parseKontaktLocation(section []byte) {
+	if len(section) < 8 {
+		return
+	}
+	f.Location = KontaktLocationAdvertisementView{
+		TxPower:     int8(section[3]),
+		BleChannel:  uint8(section[4]),
+		DeviceModel: uint8(section[5]),
+		Flags:       uint8(section[6]),
+		UniqueID:    section[7:],
+	}
+	f.DetectedType = KontaktLocation
+}
+
+func (f *Frame) parseEddystone(section []byte) error {
+	if len(section) < 3 {
+		return io.EOF
+	}
+	switch section[2] {
+	case 0x00:
+		return f.parseEddystoneUID(section)
+	case 0x10:
+		return f.parseEddystoneURL(section)
+	case 0x20:
+		return f.parseEddystoneTLM(section)
+	case 0x30:
+		return f.parseEddystoneEID(section)
+	}
+	f.parseEddystoneUnknown(section)
+	return nil
+}
+
+func (f *Frame) parseEddystoneUID(section []byte) error {
+	if len(section) != 22 {
+		return io.EOF
+	}
+	f.EddystoneUID = EddystoneUIDPacket{
+		TxPower0M:  int8(section[3]),
+		Namespace:  section[4:14],
+		InstanceId: section[14:20],
+	}
+	f.DetectedType = EddystoneUID
+	return nil
+}
+
+func (f *Frame) parseEddystoneURL(section []byte) error {
+	if len(section) < 6 {
+		return io.EOF
+	}
+	prefix, ok := eddystoneUrlPrefixes[section[4]]
+	if !ok {
+		return ErrInvalidURL
+	}
+	for _, b := range section[5:] {
+		if _, ok := eddystoneUrlReplacements[b]; !ok && (b < 0x20 || b > 0x7F) {
+			return ErrInvalidURL
+		}
+	}
+	url := append(f.EddystoneURL.URL[:0], prefix...)
+	for _, b := range section[5:] {
+		if b >= 0x20 && b <= 0x7F {
+			url = append(url, b)
+		} else {
+			url = append(url, eddystoneUrlReplacements[b]...)
+		}
+	}
+	f.EddystoneURL = EddystoneURLPacketView{
+		TxPower0M: int8(section[3]),
+		URL:       url,
+	}
+	f.DetectedType = EddystoneURL
+	return nil
+}
+
+func (f *Frame) parseEddystoneTLM(section []byte) error {
+	if len(section) < 4 {
+		return io.EOF
+	}
+	switch section[3] {
+	case 0x00:
+		return f.parseEddystonePlainTLM(section)
+	case 0x01:
+		return f.parseEddystoneEncryptedTLM(section)
+	}
+	f.parseEddystoneUnknown(section)
+	return ErrUnsupportedTLMVersion
+}
+
+func (f *Frame) parseEddystonePlainTLM(section []byte) error {
+	if len(section) != 16 {
+		return io.EOF
+	}
+	f.EddystoneTLM = EddystonePlainTLMPacket{
+		BatteryVoltage:     binary.BigEndian.Uint16(section[4:6]),
+		Temperature:        float64((int16(section[6])<<8)+int16(section[7])) / 256,
+		AdvertisementCount: binary.BigEndian.Uint32(section[8:12]),
+		TimeSincePowerOn:   float64(binary.BigEndian.Uint32(section[12:16])) / 10,
+	}
+	f.DetectedType = EddystoneTLM
+	return nil
+}
+
+func (f *Frame) parseEddystoneEncryptedTLM(section []byte) error {
+	if len(section) != 20 {
+		return io.EOF
+	}
+	f.EddystoneETLM = EddystoneEncryptedTLMPacket{
+		Telemetry: section[4:16],
+		Salt:      section[16:18],
+		MIC:       section[18:20],
+	}
+	f.DetectedType = EddystoneETLM
+	return nil
+}
+
+func (f *Frame) parseEddystoneEID(section []byte) error {
+	if len(section) != 12 {
+		return io.EOF
+	}
+	f.EddystoneEID = EddystoneEIDPacket{
+		TxPower0M: int8(section[3]),
+		EID:       section[4:12],
+	}
+	f.DetectedType = EddystoneEID
+	return nil
+}
+
+func (f *Frame) parseEddystoneUnknown(section []byte) {
+	f.EddystoneUnknown = EddystoneUnknownPacket{
+		FrameType: section[2],
+		Payload:   section[3:],
+	}
+	f.DetectedType = EddystoneUnknown
+}
+
+// Parsed returns copy of the frame as a struct kept by Parser in Parser.Parsed, e.g. *KontaktPlainAdvertisement.
+// Byte slices are copied, so result doesn't reference parsed data. Nil is returned when no frame was detected.
+func (f *Frame) Parsed() interface{} {
+	switch f.DetectedType {
+	case IBeacon:
+		ibeacon := f.IBeacon
+		return &ibeacon
+	case KontaktScanResponse:
+		return &KontaktIOScanResponse{
+			Name:          string(f.ScanResponse.Name),
+			HasName:       f.ScanResponse.HasName,
+			TxPower:       f.ScanResponse.TxPower,
+			HasTxPower:    f.ScanResponse.HasTxPower,
+			Firmware:      f.ScanResponse.firmware(),
+			BatteryLevel:  f.ScanResponse.BatteryLevel,
+			UniqueID:      string(f.ScanResponse.UniqueID),
+			HasIdentifier: f.ScanResponse.HasIdentifier,
+		}
+	case KontaktPlain:
+		return &KontaktPlainAdvertisement{
+			DeviceModel:   f.Plain.DeviceModel,
+			FirmwareMajor: f.Plain.FirmwareMajor,
+			FirmwareMinor: f.Plain.FirmwareMinor,
+			BatteryLevel:  f.Plain.BatteryLevel,
+			TxPower:       f.Plain.TxPower,
+			UniqueID:      string(f.Plain.UniqueID),
+		}
+	case KontaktShuffled:
+		shuffled := f.Shuffled
+		shuffled.EddystoneNamespace = copyBytes(shuffled.EddystoneNamespace)
+		shuffled.EddystoneInstanceID = copyBytes(shuffled.EddystoneInstanceID)
+		return &shuffled
+	case KontaktTelemetry:
+		fields := make([]KontaktTelemetryValue, len(f.Telemetry.Fields))
+		for i, field := range f.Telemetry.Fields {
+			fields[i] = KontaktTelemetryValue{PID: field.PID, Value: copyBytes(field.Value)}
+		}
+		return &KontaktTelemetryAdvertisement{Fields: fields}
+	case KontaktLocation:
+		return &KontaktLocationAdvertisement{
+			TxPower:     f.Location.TxPower,
+			BleChannel:  f.Location.BleChannel,
+			DeviceModel: f.Location.DeviceModel,
+			Flags:       f.Location.Flags,
+			UniqueID:    string(f.Location.UniqueID),
+		}
+	case EddystoneUID:
+		return &EddystoneUIDPacket{
+			TxPower0M:  f.EddystoneUID.TxPower0M,
+			Namespace:  copyBytes(f.EddystoneUID.Namespace),
+			InstanceId: copyBytes(f.EddystoneUID.InstanceId),
+		}
+	case EddystoneURL:
+		return &EddystoneURLPacket{
+			TxPower0M: f.EddystoneURL.TxPower0M,
+			URL:       string(f.EddystoneURL.URL),
+		}
+	case EddystoneTLM:
+		tlm := f.EddystoneTLM
+		return &tlm
+	case EddystoneETLM:
+		return &EddystoneEncryptedTLMPacket{
+			Telemetry: copyBytes(f.EddystoneETLM.Telemetry),
+			Salt:      copyBytes(f.EddystoneETLM.Salt),
+			MIC:       copyBytes(f.EddystoneETLM.MIC),
+		}
+	case EddystoneEID:
+		return &EddystoneEIDPacket{
+			TxPower0M: f.EddystoneEID.TxPower0M,
+			EID:       copyBytes(f.EddystoneEID.EID),
+		}
+	case EddystoneUnknown:
+		return &EddystoneUnknownPacket{
+			FrameType: f.EddystoneUnknown.FrameType,
+			Payload:   copyBytes(f.EddystoneUnknown.Payload),
+		}
+	}
+	return nil
+}
+
+func (r *KontaktIOScanResponseView) firmware() string {
+	if !r.HasIdentifier {
+		return ""
+	}
+	return FirmwareVersion{Major: r.FirmwareMajor, Minor: r.FirmwareMinor}.String()
+}
+
+// copyBytes copies b into a new slice, which is never nil
+func copyBytes(b []byte) []byte {
+	return append([]byte{}, b...)
+}
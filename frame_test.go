@@ -0,0 +1,154 @@
+package kontaktparser
+
+import (
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func decodeFixture(t testing.TB, dataHex string) []byte {
+	data, err := hex.DecodeString(dataHex)
+	assert.Nil(t, err)
+	return data
+}
+
+func TestFrameMatchesParser(t *testing.T) {
+	frame := Frame{}
+	for typ, dataHex := range frameFixtures {
+		data := decodeFixture(t, dataHex)
+		assert.Nil(t, frame.ParseAdvertisement(data))
+		assert.Equal(t, typ, frame.DetectedType)
+		assert.Equal(t, parseFixture(t, dataHex, false).Parsed, frame.Parsed(), typ.String())
+	}
+
+	assert.Nil(t, frame.ParseScanResponse(decodeFixture(t, scanResponseFixture)))
+	assert.Equal(t, KontaktScanResponse, frame.DetectedType)
+	assert.Equal(t, parseFixture(t, scanResponseFixture, true).Parsed, frame.Parsed())
+}
+
+func TestFrameReferencesInput(t *testing.T) {
+	data := decodeFixture(t, frameFixtures[KontaktPlain])
+	frame := Frame{}
+	assert.Nil(t, frame.ParseAdvertisement(data))
+	assert.Equal(t, []byte("abcdef"), frame.Plain.UniqueID)
+
+	parsed := frame.Parsed()
+	data[len(data)-1] = 'x'
+	assert.Equal(t, []byte("abcdex"), frame.Plain.UniqueID)
+	assert.Equal(t, "abcdef", parsed.(*KontaktPlainAdvertisement).UniqueID)
+}
+
+func TestFrameReusesBuffers(t *testing.T) {
+	frame := Frame{}
+	assert.Nil(t, frame.ParseAdvertisement(decodeFixture(t, frameFixtures[EddystoneURL])))
+	assert.Equal(t, "https://test.biz", string(frame.EddystoneURL.URL))
+	assert.Nil(t, frame.ParseAdvertisement(decodeFixture(t, "0A16AAFE1004007869000A")))
+	assert.Equal(t, "http://www.xi.com/.net", string(frame.EddystoneURL.URL))
+
+	assert.Nil(t, frame.ParseAdvertisement(decodeFixture(t, frameFixtures[KontaktTelemetry])))
+	assert.Len(t, frame.Telemetry.Fields, 2)
+	assert.Nil(t, frame.ParseAdvertisement(decodeFixture(t, "07166AFE03020A64")))
+	assert.Len(t, frame.Telemetry.Fields, 1)
+}
+
+func TestFrameKeepsPreviousSectionOnError(t *testing.T) {
+	frame := Frame{}
+	err := frame.ParseAdvertisement(decodeFixture(t, "0B16AAFE100403746573740C"+"08166AFE03020A640411"))
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, EddystoneURL, frame.DetectedType)
+	assert.Equal(t, "https://test.biz", string(frame.EddystoneURL.URL))
+}
+
+func TestFrameResetsDetectedType(t *testing.T) {
+	frame := Frame{}
+	assert.Nil(t, frame.ParseAdvertisement(decodeFixture(t, "020106"+frameFixtures[IBeacon])))
+	assert.Equal(t, IBeacon, frame.DetectedType)
+	assert.Equal(t, byte(0x06), frame.Flags)
+	assert.True(t, frame.HasFlags)
+
+	assert.Nil(t, frame.ParseAdvertisement(decodeFixture(t, "0303AAFE")))
+	assert.Equal(t, Unknown, frame.DetectedType)
+	assert.Equal(t, byte(0), frame.Flags)
+	assert.False(t, frame.HasFlags)
+	assert.Nil(t, frame.Parsed())
+}
+
+func TestFrameEmptySections(t *testing.T) {
+	frame := Frame{}
+	assert.Equal(t, io.EOF, frame.ParseAdvertisement([]byte{0x01, 0x01}))
+	assert.Equal(t, io.EOF, frame.ParseScanResponse([]byte{0x01, 0x0A}))
+}
+
+func TestFrameDoesNotAllocate(t *testing.T) {
+	frame := Frame{}
+	for typ, dataHex := range frameFixtures {
+		data := decodeFixture(t, dataHex)
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = frame.ParseAdvertisement(data)
+		})
+		assert.Equal(t, float64(0), allocs, typ.String())
+	}
+	data := decodeFixture(t, scanResponseFixture)
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = frame.ParseScanResponse(data)
+	})
+	assert.Equal(t, float64(0), allocs)
+}
+
+func benchmarkFrame(b *testing.B, dataHex string, scanResponse bool) {
+	data := decodeFixture(b, dataHex)
+	frame := Frame{}
+	parse := frame.ParseAdvertisement
+	if scanResponse {
+		parse = frame.ParseScanResponse
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := parse(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkParser(b *testing.B, dataHex string, scanResponse bool) {
+	data := decodeFixture(b, dataHex)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser := New(data)
+		var err error
+		if scanResponse {
+			err = parser.ParseScanResponse()
+		} else {
+			err = parser.ParseAdvertisement()
+		}
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFrame(b *testing.B) {
+	for typ, dataHex := range frameFixtures {
+		b.Run(typ.String(), func(b *testing.B) {
+			benchmarkFrame(b, dataHex, false)
+		})
+	}
+	b.Run(KontaktScanResponse.String(), func(b *testing.B) {
+		benchmarkFrame(b, scanResponseFixture, true)
+	})
+}
+
+func BenchmarkParser(b *testing.B) {
+	for typ, dataHex := range frameFixtures {
+		b.Run(typ.String(), func(b *testing.B) {
+			benchmarkParser(b, dataHex, false)
+		})
+	}
+	b.Run(KontaktScanResponse.String(), func(b *testing.B) {
+		benchmarkParser(b, scanResponseFixture, true)
+	})
+}
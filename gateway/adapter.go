@@ -0,0 +1,94 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown gateway payload format")
+)
+
+// Adapter recognises and decodes payload format published by one gateway vendor
+type Adapter interface {
+	// Name returns name of the gateway format
+	Name() string
+	// Detect reports whether payload looks like this gateway format
+	Detect(payload []byte) bool
+	// Decode normalises payload into scan records
+	Decode(payload []byte) ([]kontaktparser.ScanRecord, error)
+}
+
+// Adapters is the list of known gateway formats, in detection order
+var Adapters = []Adapter{
+	Kontakt,
+	Minew,
+	Cassia,
+	Ingics,
+	AprilBrother,
+}
+
+// Detect returns adapter recognising payload
+func Detect(payload []byte) (Adapter, error) {
+	for _, adapter := range Adapters {
+		if adapter.Detect(payload) {
+			return adapter, nil
+		}
+	}
+	return nil, ErrUnknownFormat
+}
+
+// Decode detects payload format and normalises it into scan records.
+// Formats which do not carry gateway identity leave ReceiverID empty.
+func Decode(payload []byte) ([]kontaktparser.ScanRecord, error) {
+	adapter, err := Detect(payload)
+	if err != nil {
+		return nil, err
+	}
+	return adapter.Decode(payload)
+}
+
+// DecodeAndParse detects payload format, normalises it and parses all records
+func DecodeAndParse(payload []byte) ([]kontaktparser.ParsedRecord, error) {
+	records, err := Decode(payload)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(records), nil
+}
+
+// jsonKeys returns keys of the JSON object, or of the first object in JSON array
+func jsonKeys(payload []byte) map[string]bool {
+	trimmed := bytes.TrimSpace(payload)
+	if bytes.HasPrefix(trimmed, []byte("[")) {
+		items := make([]json.RawMessage, 0)
+		if err := json.Unmarshal(trimmed, &items); err != nil || len(items) == 0 {
+			return nil
+		}
+		trimmed = items[0]
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(trimmed, &object); err != nil {
+		return nil
+	}
+	keys := make(map[string]bool, len(object))
+	for key := range object {
+		keys[key] = true
+	}
+	return keys
+}
+
+// unmarshalObjects decodes single JSON object or array of objects
+func unmarshalObjects(payload []byte, single interface{}, list interface{}) (bool, error) {
+	trimmed := bytes.TrimSpace(payload)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		return false, json.Unmarshal(trimmed, single)
+	case bytes.HasPrefix(trimmed, []byte("[")):
+		return true, json.Unmarshal(trimmed, list)
+	}
+	return false, ErrInvalidPayload
+}
@@ -0,0 +1,125 @@
+package gateway
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	payload, err := ioutil.ReadFile("testdata/" + name)
+	assert.Nil(t, err)
+	return payload
+}
+
+func TestDetect(t *testing.T) {
+	fixtures := map[string]Adapter{
+		"kontakt_batch.json": Kontakt,
+		"minew_g1.json":      Minew,
+		"cassia_x1000.json":  Cassia,
+		"ingics_igs.txt":     Ingics,
+		"aprilbrother.json":  AprilBrother,
+	}
+	for name, expected := range fixtures {
+		adapter, err := Detect(readFixture(t, name))
+		assert.Nil(t, err, name)
+		assert.Equal(t, expected.Name(), adapter.Name(), name)
+	}
+
+	_, err := Detect([]byte(`{"foo": "bar"}`))
+	assert.Equal(t, ErrUnknownFormat, err)
+	_, err = Decode([]byte("not a payload"))
+	assert.Equal(t, ErrUnknownFormat, err)
+}
+
+func TestDecodeMinew(t *testing.T) {
+	parsed, err := DecodeAndParse(readFixture(t, "minew_g1.json"))
+	assert.Nil(t, err)
+	assert.Len(t, parsed, 2)
+
+	assert.Equal(t, "AC:23:3F:C0:12:34", parsed[0].ReceiverID)
+	assert.Equal(t, "F1:A2:B3:C4:D5:E6", parsed[0].MAC)
+	assert.Equal(t, int8(-63), parsed[0].RSSI)
+	assert.Equal(t, time.Date(2019, 10, 24, 8, 0, 0, 250000000, time.UTC), parsed[0].Received)
+	assert.Nil(t, parsed[0].Err)
+	assert.Equal(t, kontaktparser.IBeacon, parsed[0].DetectedType)
+
+	assert.Equal(t, kontaktparser.KontaktPlain, parsed[1].DetectedType)
+}
+
+func TestDecodeCassia(t *testing.T) {
+	parsed, err := DecodeAndParse(readFixture(t, "cassia_x1000.json"))
+	assert.Nil(t, err)
+	assert.Len(t, parsed, 2)
+
+	assert.Equal(t, "CC:1B:E0:E0:12:34", parsed[0].ReceiverID)
+	assert.Equal(t, "F1:A2:B3:C4:D5:E6", parsed[0].MAC)
+	assert.Equal(t, int8(-59), parsed[0].RSSI)
+	assert.False(t, parsed[0].ScanResponse)
+	assert.Equal(t, kontaktparser.KontaktPlain, parsed[0].DetectedType)
+
+	assert.True(t, parsed[1].ScanResponse)
+	assert.Nil(t, parsed[1].Err)
+	assert.Equal(t, kontaktparser.KontaktScanResponse, parsed[1].DetectedType)
+
+	_, err = Cassia.Decode([]byte(`{"bdaddrs": [], "adData": "0201", "rssi": -50}`))
+	assert.Equal(t, ErrInvalidPayload, err)
+}
+
+func TestDecodeIngics(t *testing.T) {
+	parsed, err := DecodeAndParse(readFixture(t, "ingics_igs.txt"))
+	assert.Nil(t, err)
+	assert.Len(t, parsed, 2)
+
+	assert.Equal(t, "C0:A1:B2:C3:D4:E5", parsed[0].ReceiverID)
+	assert.Equal(t, "F1:A2:B3:C4:D5:E6", parsed[0].MAC)
+	assert.Equal(t, int8(-64), parsed[0].RSSI)
+	assert.Equal(t, time.Unix(1571904000, 0).UTC(), parsed[0].Received)
+	assert.Equal(t, kontaktparser.KontaktPlain, parsed[0].DetectedType)
+
+	assert.True(t, parsed[1].ScanResponse)
+	assert.Equal(t, kontaktparser.KontaktScanResponse, parsed[1].DetectedType)
+
+	records, err := Ingics.Decode([]byte("$GPRP,F1A2B3C4D5E6,C0A1B2C3D4E5,-64,0201060F166AFE0206010F6404616263646566"))
+	assert.Nil(t, err)
+	assert.True(t, records[0].Received.IsZero())
+
+	_, err = Ingics.Decode([]byte("$GPRP,F1A2B3C4D5E6,C0A1B2C3D4E5,loud,0201"))
+	assert.Equal(t, ErrInvalidPayload, err)
+}
+
+func TestDecodeAprilBrother(t *testing.T) {
+	parsed, err := DecodeAndParse(readFixture(t, "aprilbrother.json"))
+	assert.Nil(t, err)
+	assert.Len(t, parsed, 2)
+
+	assert.Equal(t, "AC:BF:A1:B2:C3:D4", parsed[0].ReceiverID)
+	assert.Equal(t, "F1:A2:B3:C4:D5:E6", parsed[0].MAC)
+	assert.Equal(t, int8(-62), parsed[0].RSSI)
+	assert.Equal(t, time.Unix(1571904000, 0).UTC(), parsed[0].Received)
+	assert.Equal(t, kontaktparser.KontaktPlain, parsed[0].DetectedType)
+
+	assert.True(t, parsed[1].ScanResponse)
+	assert.Equal(t, kontaktparser.KontaktScanResponse, parsed[1].DetectedType)
+
+	_, err = AprilBrother.Decode([]byte(`{"mac": "ACBFA1B2C3D4", "devices": ["00F1A2"]}`))
+	assert.Equal(t, ErrInvalidPayload, err)
+}
+
+func TestDecodeRawData(t *testing.T) {
+	data, err := decodeRawData(" 0x00112233 ", hexEncoding)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x00, 0x11, 0x22, 0x33}, data)
+
+	data, err = decodeRawData("AAAA", base64Encoding)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x00, 0x00, 0x00}, data)
+
+	_, err = decodeRawData("0xAAAA", base64Encoding)
+	assert.Equal(t, ErrInvalidPayload, err)
+	_, err = decodeRawData("AgEG", hexEncoding)
+	assert.Equal(t, ErrInvalidPayload, err)
+}
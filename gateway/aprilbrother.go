@@ -0,0 +1,69 @@
+package gateway
+
+import (
+	"encoding/hex"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+const (
+	// aprilBrotherHeaderLength - advertisement type, 6 bytes of MAC and RSSI
+	aprilBrotherHeaderLength = 8
+	// aprilBrotherScanResponse - advertisement type of scan response
+	aprilBrotherScanResponse = 4
+)
+
+// aprilBrotherPayload is a report published by April Brother gateway in JSON mode
+type aprilBrotherPayload struct {
+	Version int      `json:"v"`
+	Time    int64    `json:"time"`
+	MAC     string   `json:"mac"`
+	Devices []string `json:"devices"`
+}
+
+// AprilBrother is the adapter for April Brother BLE gateways in JSON mode. Payload is
+// {"v", "mid", "time", "ip", "mac", "devices": [...]} where each device is a hex string of
+// advertisement type, MAC, RSSI and advertisement data.
+var AprilBrother Adapter = aprilBrotherAdapter{}
+
+type aprilBrotherAdapter struct{}
+
+func (aprilBrotherAdapter) Name() string {
+	return "aprilbrother"
+}
+
+func (aprilBrotherAdapter) Detect(payload []byte) bool {
+	keys := jsonKeys(payload)
+	return keys["devices"] && keys["mac"]
+}
+
+func (aprilBrotherAdapter) Decode(payload []byte) ([]kontaktparser.ScanRecord, error) {
+	report := aprilBrotherPayload{}
+	reports := make([]aprilBrotherPayload, 0)
+	isList, err := unmarshalObjects(payload, &report, &reports)
+	if err != nil {
+		return nil, err
+	}
+	if !isList {
+		reports = append(reports, report)
+	}
+
+	records := make([]kontaktparser.ScanRecord, 0)
+	for _, report := range reports {
+		for _, device := range report.Devices {
+			raw, err := hex.DecodeString(device)
+			if err != nil || len(raw) < aprilBrotherHeaderLength {
+				return nil, ErrInvalidPayload
+			}
+			records = append(records, kontaktparser.ScanRecord{
+				ReceiverID:   normalizeMAC(report.MAC),
+				MAC:          normalizeMAC(hex.EncodeToString(raw[1:7])),
+				RSSI:         int8(raw[7]),
+				Received:     unixTime(report.Time),
+				Data:         raw[aprilBrotherHeaderLength:],
+				ScanResponse: raw[0] == aprilBrotherScanResponse,
+			})
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,80 @@
+package gateway
+
+import (
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+// cassiaScanResponseEvent - evtType value of Cassia scan response events
+const cassiaScanResponseEvent = 4
+
+type cassiaAddress struct {
+	Address string `json:"bdaddr"`
+	Type    string `json:"bdaddrType"`
+}
+
+// cassiaEvent is a scan event published by Cassia gateway
+type cassiaEvent struct {
+	Addresses []cassiaAddress `json:"bdaddrs"`
+	AdData    string          `json:"adData"`
+	ScanData  string          `json:"scanData"`
+	RSSI      int8            `json:"rssi"`
+	EvtType   int             `json:"evtType"`
+	Gateway   string          `json:"gateway"`
+}
+
+// Cassia is the adapter for Cassia X1000 gateways. Payload is a scan event or array of events
+// {"bdaddrs": [{"bdaddr"}], "adData", "scanData", "rssi", "evtType"}, optionally with "gateway" MAC.
+// Events do not carry receive time.
+var Cassia Adapter = cassiaAdapter{}
+
+type cassiaAdapter struct{}
+
+func (cassiaAdapter) Name() string {
+	return "cassia"
+}
+
+func (cassiaAdapter) Detect(payload []byte) bool {
+	return jsonKeys(payload)["bdaddrs"]
+}
+
+func (cassiaAdapter) Decode(payload []byte) ([]kontaktparser.ScanRecord, error) {
+	event := cassiaEvent{}
+	events := make([]cassiaEvent, 0)
+	isList, err := unmarshalObjects(payload, &event, &events)
+	if err != nil {
+		return nil, err
+	}
+	if !isList {
+		events = append(events, event)
+	}
+
+	records := make([]kontaktparser.ScanRecord, 0, len(events))
+	for _, event := range events {
+		if len(event.Addresses) == 0 {
+			return nil, ErrInvalidPayload
+		}
+		record := kontaktparser.ScanRecord{
+			MAC:  normalizeMAC(event.Addresses[0].Address),
+			RSSI: event.RSSI,
+		}
+		if event.Gateway != "" {
+			record.ReceiverID = normalizeMAC(event.Gateway)
+		}
+		if event.AdData != "" && event.EvtType != cassiaScanResponseEvent {
+			record.Data, err = decodeRawData(event.AdData, hexEncoding)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+		if event.ScanData != "" {
+			record.Data, err = decodeRawData(event.ScanData, hexEncoding)
+			if err != nil {
+				return nil, err
+			}
+			record.ScanResponse = true
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
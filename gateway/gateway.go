@@ -0,0 +1,75 @@
+// Package gateway decodes scan batches published by BLE gateways into kontaktparser scan records.
+package gateway
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+var (
+	ErrInvalidPayload = errors.New("invalid gateway payload")
+)
+
+// rawDataEncoding is an encoding of advertisement data in gateway payload
+type rawDataEncoding int
+
+const (
+	// hexEncoding - hex string, optionally prefixed with "0x"
+	hexEncoding rawDataEncoding = iota
+	// base64Encoding - standard base64 string
+	base64Encoding
+)
+
+// decodeRawData decodes advertisement data sent in given encoding
+func decodeRawData(data string, encoding rawDataEncoding) ([]byte, error) {
+	data = strings.TrimSpace(data)
+	var decoded []byte
+	var err error
+	if encoding == base64Encoding {
+		decoded, err = base64.StdEncoding.DecodeString(data)
+	} else {
+		decoded, err = hex.DecodeString(strings.TrimPrefix(data, "0x"))
+	}
+	if err != nil {
+		return nil, ErrInvalidPayload
+	}
+	return decoded, nil
+}
+
+// unixTime converts timestamp in seconds or milliseconds since epoch to time, zero timestamp means unknown time
+func unixTime(timestamp int64) time.Time {
+	if timestamp == 0 {
+		return time.Time{}
+	}
+	if timestamp > 1e11 {
+		return time.Unix(0, timestamp*int64(time.Millisecond)).UTC()
+	}
+	return time.Unix(timestamp, 0).UTC()
+}
+
+// normalizeMAC converts MAC address to upper case, colon separated form
+func normalizeMAC(mac string) string {
+	mac = strings.ToUpper(strings.TrimSpace(mac))
+	if len(mac) == 12 && !strings.ContainsAny(mac, ":-") {
+		parts := make([]string, 6)
+		for i := range parts {
+			parts[i] = mac[2*i : 2*i+2]
+		}
+		return strings.Join(parts, ":")
+	}
+	return strings.Replace(mac, "-", ":", -1)
+}
+
+// Parse parses all records
+func Parse(records []kontaktparser.ScanRecord) []kontaktparser.ParsedRecord {
+	parsed := make([]kontaktparser.ParsedRecord, len(records))
+	for i, record := range records {
+		parsed[i] = kontaktparser.ParseRecord(record)
+	}
+	return parsed
+}
@@ -0,0 +1,70 @@
+package gateway
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+const (
+	// ingicsAdvertisement - message header of Ingics advertisement report
+	ingicsAdvertisement = "$GPRP"
+	// ingicsScanResponse - message header of Ingics scan response report
+	ingicsScanResponse = "$RSPR"
+)
+
+// Ingics is the adapter for Ingics iGS gateways. Payload is one report per line:
+// "$GPRP,<beacon MAC>,<gateway MAC>,<rssi>,<hex data>[,<unix timestamp>]",
+// with "$RSPR" header for scan responses.
+var Ingics Adapter = ingicsAdapter{}
+
+type ingicsAdapter struct{}
+
+func (ingicsAdapter) Name() string {
+	return "ingics"
+}
+
+func (ingicsAdapter) Detect(payload []byte) bool {
+	trimmed := bytes.TrimSpace(payload)
+	return bytes.HasPrefix(trimmed, []byte(ingicsAdvertisement)) || bytes.HasPrefix(trimmed, []byte(ingicsScanResponse))
+}
+
+func (ingicsAdapter) Decode(payload []byte) ([]kontaktparser.ScanRecord, error) {
+	records := make([]kontaktparser.ScanRecord, 0)
+	for _, line := range strings.Split(string(payload), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, ",")
+		if len(fields) < 5 || (fields[0] != ingicsAdvertisement && fields[0] != ingicsScanResponse) {
+			return nil, ErrInvalidPayload
+		}
+		rssi, err := strconv.ParseInt(fields[3], 10, 8)
+		if err != nil {
+			return nil, ErrInvalidPayload
+		}
+		data, err := decodeRawData(fields[4], hexEncoding)
+		if err != nil {
+			return nil, err
+		}
+		record := kontaktparser.ScanRecord{
+			ReceiverID:   normalizeMAC(fields[2]),
+			MAC:          normalizeMAC(fields[1]),
+			RSSI:         int8(rssi),
+			Data:         data,
+			ScanResponse: fields[0] == ingicsScanResponse,
+		}
+		if len(fields) > 5 && fields[5] != "" {
+			timestamp, err := strconv.ParseInt(fields[5], 10, 64)
+			if err != nil {
+				return nil, ErrInvalidPayload
+			}
+			record.Received = unixTime(timestamp)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
@@ -0,0 +1,147 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+// kontaktEvent is a single device sighting in Kontakt.io gateway batch
+type kontaktEvent struct {
+	SourceID      string `json:"sourceId"`
+	DeviceAddress string `json:"deviceAddress"`
+	RSSI          int8   `json:"rssi"`
+	Timestamp     int64  `json:"timestamp"`
+	Data          string `json:"data"`
+	RawData       string `json:"rawData"`
+	SRData        string `json:"srData"`
+}
+
+// kontaktBatch is a scan batch published by Kontakt.io gateway
+type kontaktBatch struct {
+	SourceID  string         `json:"sourceId"`
+	Timestamp int64          `json:"timestamp"`
+	Events    []kontaktEvent `json:"events"`
+}
+
+// DecodeKontakt decodes scan batch published by Kontakt.io gateways. Payload is either a single batch
+// object {"sourceId", "timestamp", "events": [...]}, an array of such batches, or an array of events.
+// Event holds "deviceAddress", "rssi", "timestamp" (seconds or milliseconds) and advertisement as base64
+// string in "data" or as hex string in "rawData". Optional "srData" holds base64 scan response, which is
+// returned as a separate record.
+func DecodeKontakt(payload []byte) ([]kontaktparser.ScanRecord, error) {
+	batches := make([]kontaktBatch, 0)
+	trimmed := bytes.TrimSpace(payload)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		batch := kontaktBatch{}
+		if err := json.Unmarshal(trimmed, &batch); err != nil {
+			return nil, err
+		}
+		batches = append(batches, batch)
+	case bytes.HasPrefix(trimmed, []byte("[")):
+		items := make([]json.RawMessage, 0)
+		if err := json.Unmarshal(trimmed, &items); err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			batch := kontaktBatch{}
+			if err := json.Unmarshal(item, &batch); err != nil {
+				return nil, err
+			}
+			if batch.Events == nil {
+				// item is an event, not a batch
+				event := kontaktEvent{}
+				if err := json.Unmarshal(item, &event); err != nil {
+					return nil, err
+				}
+				batch = kontaktBatch{Events: []kontaktEvent{event}}
+			}
+			batches = append(batches, batch)
+		}
+	default:
+		return nil, ErrInvalidPayload
+	}
+
+	records := make([]kontaktparser.ScanRecord, 0)
+	for _, batch := range batches {
+		for _, event := range batch.Events {
+			eventRecords, err := batch.records(event)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, eventRecords...)
+		}
+	}
+	return records, nil
+}
+
+func (b *kontaktBatch) records(event kontaktEvent) ([]kontaktparser.ScanRecord, error) {
+	if event.DeviceAddress == "" {
+		return nil, ErrInvalidPayload
+	}
+	record := kontaktparser.ScanRecord{
+		ReceiverID: b.SourceID,
+		MAC:        normalizeMAC(event.DeviceAddress),
+		RSSI:       event.RSSI,
+		Received:   unixTime(b.Timestamp),
+	}
+	if event.SourceID != "" {
+		record.ReceiverID = event.SourceID
+	}
+	if event.Timestamp != 0 {
+		record.Received = unixTime(event.Timestamp)
+	}
+
+	records := make([]kontaktparser.ScanRecord, 0, 2)
+	data, encoding := event.Data, base64Encoding
+	if data == "" {
+		data, encoding = event.RawData, hexEncoding
+	}
+	if data != "" {
+		decoded, err := decodeRawData(data, encoding)
+		if err != nil {
+			return nil, err
+		}
+		record.Data = decoded
+		records = append(records, record)
+	}
+	if event.SRData != "" {
+		decoded, err := decodeRawData(event.SRData, base64Encoding)
+		if err != nil {
+			return nil, err
+		}
+		record.Data = decoded
+		record.ScanResponse = true
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// ParseKontakt decodes Kontakt.io gateway batch and parses all its records
+func ParseKontakt(payload []byte) ([]kontaktparser.ParsedRecord, error) {
+	records, err := DecodeKontakt(payload)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(records), nil
+}
+
+// Kontakt is the adapter for Kontakt.io gateways
+var Kontakt Adapter = kontaktAdapter{}
+
+type kontaktAdapter struct{}
+
+func (kontaktAdapter) Name() string {
+	return "kontakt"
+}
+
+func (kontaktAdapter) Detect(payload []byte) bool {
+	keys := jsonKeys(payload)
+	return keys["events"] || keys["deviceAddress"]
+}
+
+func (kontaktAdapter) Decode(payload []byte) ([]kontaktparser.ScanRecord, error) {
+	return DecodeKontakt(payload)
+}
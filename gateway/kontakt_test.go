@@ -0,0 +1,89 @@
+package gateway
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+func TestDecodeKontaktBatch(t *testing.T) {
+	payload, err := ioutil.ReadFile("testdata/kontakt_batch.json")
+	assert.Nil(t, err)
+
+	records, err := DecodeKontakt(payload)
+	assert.Nil(t, err)
+	assert.Len(t, records, 3)
+
+	assert.Equal(t, "GW16-a1b2c3", records[0].ReceiverID)
+	assert.Equal(t, "F1:A2:B3:C4:D5:E6", records[0].MAC)
+	assert.Equal(t, int8(-61), records[0].RSSI)
+	assert.Equal(t, time.Unix(1571904000, 123000000).UTC(), records[0].Received)
+	assert.False(t, records[0].ScanResponse)
+
+	assert.Equal(t, "F1:A2:B3:C4:D5:E6", records[1].MAC)
+	assert.True(t, records[1].ScanResponse)
+
+	assert.Equal(t, "C0:A1:B2:C3:D4:E5", records[2].MAC)
+	assert.Equal(t, time.Unix(1571904000, 0).UTC(), records[2].Received)
+	assert.Equal(t, records[0].Data, records[2].Data)
+}
+
+func TestParseKontaktBatch(t *testing.T) {
+	payload, err := ioutil.ReadFile("testdata/kontakt_batch.json")
+	assert.Nil(t, err)
+
+	parsed, err := ParseKontakt(payload)
+	assert.Nil(t, err)
+	assert.Len(t, parsed, 3)
+
+	assert.Nil(t, parsed[0].Err)
+	assert.Equal(t, kontaktparser.KontaktPlain, parsed[0].DetectedType)
+	plain := parsed[0].Parsed.(*kontaktparser.KontaktPlainAdvertisement)
+	assert.Equal(t, "abcdef", plain.UniqueID)
+
+	assert.Nil(t, parsed[1].Err)
+	assert.Equal(t, kontaktparser.KontaktScanResponse, parsed[1].DetectedType)
+	assert.Equal(t, "GW16-a1b2c3", parsed[1].ReceiverID)
+}
+
+func TestDecodeKontaktEvents(t *testing.T) {
+	payload, err := ioutil.ReadFile("testdata/kontakt_events.json")
+	assert.Nil(t, err)
+
+	_, err = DecodeKontakt(payload)
+	assert.Equal(t, ErrInvalidPayload, err)
+
+	records, err := DecodeKontakt([]byte(`[{"sourceId": "portal-beam-1", "deviceAddress": "F1-A2-B3-C4-D5-E6", "rssi": -58, "timestamp": 1571904010, "data": "AgEGDxZq/gIGAQ9kBGFiY2RlZg=="}]`))
+	assert.Nil(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "portal-beam-1", records[0].ReceiverID)
+	assert.Equal(t, "F1:A2:B3:C4:D5:E6", records[0].MAC)
+	assert.Equal(t, time.Unix(1571904010, 0).UTC(), records[0].Received)
+}
+
+func TestDecodeKontaktEncodings(t *testing.T) {
+	// base64 made of hex digits only is still base64
+	records, err := DecodeKontakt([]byte(`{"events": [{"deviceAddress": "F1A2B3C4D5E6", "data": "00112233", "srData": "AAAA"}]}`))
+	assert.Nil(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, []byte{0xd3, 0x4d, 0x75, 0xdb, 0x6d, 0xf7}, records[0].Data)
+	assert.Equal(t, []byte{0x00, 0x00, 0x00}, records[1].Data)
+
+	records, err = DecodeKontakt([]byte(`{"events": [{"deviceAddress": "F1A2B3C4D5E6", "rawData": "0x020106"}]}`))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x02, 0x01, 0x06}, records[0].Data)
+
+	_, err = DecodeKontakt([]byte(`{"events": [{"deviceAddress": "F1A2B3C4D5E6", "rawData": "AgEG"}]}`))
+	assert.Equal(t, ErrInvalidPayload, err)
+}
+
+func TestDecodeKontaktInvalid(t *testing.T) {
+	_, err := DecodeKontakt([]byte(`"abc"`))
+	assert.Equal(t, ErrInvalidPayload, err)
+
+	_, err = DecodeKontakt([]byte(`{"events": [{"rssi": -50, "data": "0201"}]}`))
+	assert.Equal(t, ErrInvalidPayload, err)
+}
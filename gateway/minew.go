@@ -0,0 +1,80 @@
+package gateway
+
+import (
+	"strings"
+	"time"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+// minewEntry is a single element of Minew G1 payload array
+type minewEntry struct {
+	Timestamp string `json:"timestamp"`
+	Type      string `json:"type"`
+	MAC       string `json:"mac"`
+	RSSI      int8   `json:"rssi"`
+	RawData   string `json:"rawData"`
+}
+
+// Minew is the adapter for Minew G1 gateways. Payload is an array of entries
+// {"timestamp", "type", "mac", "rssi", "rawData"}; entry of type "Gateway" identifies the receiver.
+var Minew Adapter = minewAdapter{}
+
+type minewAdapter struct{}
+
+func (minewAdapter) Name() string {
+	return "minew"
+}
+
+func (minewAdapter) Detect(payload []byte) bool {
+	keys := jsonKeys(payload)
+	return keys["type"] && keys["mac"] && keys["timestamp"]
+}
+
+func (minewAdapter) Decode(payload []byte) ([]kontaktparser.ScanRecord, error) {
+	entry := minewEntry{}
+	entries := make([]minewEntry, 0)
+	isList, err := unmarshalObjects(payload, &entry, &entries)
+	if err != nil {
+		return nil, err
+	}
+	if !isList {
+		entries = append(entries, entry)
+	}
+
+	receiverID := ""
+	for _, entry := range entries {
+		if strings.EqualFold(entry.Type, "Gateway") {
+			receiverID = normalizeMAC(entry.MAC)
+		}
+	}
+
+	records := make([]kontaktparser.ScanRecord, 0, len(entries))
+	for _, entry := range entries {
+		if strings.EqualFold(entry.Type, "Gateway") || entry.RawData == "" {
+			continue
+		}
+		if entry.MAC == "" {
+			return nil, ErrInvalidPayload
+		}
+		data, err := decodeRawData(entry.RawData, hexEncoding)
+		if err != nil {
+			return nil, err
+		}
+		received := time.Time{}
+		if entry.Timestamp != "" {
+			received, err = time.Parse(time.RFC3339Nano, entry.Timestamp)
+			if err != nil {
+				return nil, ErrInvalidPayload
+			}
+		}
+		records = append(records, kontaktparser.ScanRecord{
+			ReceiverID: receiverID,
+			MAC:        normalizeMAC(entry.MAC),
+			RSSI:       entry.RSSI,
+			Received:   received,
+			Data:       data,
+		})
+	}
+	return records, nil
+}
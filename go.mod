@@ -3,6 +3,10 @@ module github.com/sz33psz/kontakt-beacon-parser
 go 1.12
 
 require (
+	github.com/eclipse/paho.mqtt.golang v1.2.0
+	github.com/fxamacker/cbor/v2 v2.5.0
 	github.com/google/uuid v1.1.1
 	github.com/stretchr/testify v1.4.0
+	golang.org/x/net v0.11.0 // indirect
+	google.golang.org/protobuf v1.28.1
 )
@@ -0,0 +1,90 @@
+package kontaktparser
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	influxMeasurementEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`, " ", `\ `)
+	influxTagEscaper         = strings.NewReplacer(`\`, `\\`, ",", `\,`, "=", `\=`, " ", `\ `)
+	influxStringEscaper      = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+)
+
+// InfluxWriter writes parsed records in InfluxDB line protocol. Measurement is named after frame type
+// (e.g. kontakt_plain), beacon identity, MAC and receiver are tags and frame values are fields,
+// see CSVColumns for field names of each frame type. RSSI is written as "rssi" field of every line.
+type InfluxWriter struct {
+	w *bufio.Writer
+}
+
+// NewInfluxWriter creates InfluxWriter writing lines to w
+func NewInfluxWriter(w io.Writer) *InfluxWriter {
+	return &InfluxWriter{w: bufio.NewWriter(w)}
+}
+
+// Write writes a single line with record values. ErrNotExportable is returned for records which failed parsing.
+func (i *InfluxWriter) Write(record ParsedRecord) error {
+	typ, err := exportFrameType(record)
+	if err != nil {
+		return err
+	}
+	line := []byte(influxMeasurementEscaper.Replace(typ.String()))
+	line = appendInfluxTag(line, "id", exportID(record))
+	line = appendInfluxTag(line, "mac", strings.ToUpper(record.MAC))
+	line = appendInfluxTag(line, "receiver", record.ReceiverID)
+
+	line = append(line, " rssi="...)
+	line = strconv.AppendInt(line, int64(record.RSSI), 10)
+	line = append(line, 'i')
+	values := exportValues(record.Parsed)
+	for _, column := range exportColumns[typ] {
+		value, ok := values[column]
+		if !ok {
+			continue
+		}
+		line = append(line, ',')
+		line = append(line, influxTagEscaper.Replace(column)...)
+		line = append(line, '=')
+		line = appendInfluxValue(line, value)
+	}
+	if !record.Received.IsZero() {
+		line = append(line, ' ')
+		line = strconv.AppendInt(line, record.Received.UnixNano(), 10)
+	}
+	line = append(line, '\n')
+	if _, err := i.w.Write(line); err != nil {
+		return err
+	}
+	return i.w.Flush()
+}
+
+// appendInfluxTag appends tag to line, tags with empty values are skipped as line protocol doesn't allow them
+func appendInfluxTag(line []byte, key string, value string) []byte {
+	if value == "" {
+		return line
+	}
+	line = append(line, ',')
+	line = append(line, influxTagEscaper.Replace(key)...)
+	line = append(line, '=')
+	return append(line, influxTagEscaper.Replace(value)...)
+}
+
+func appendInfluxValue(line []byte, value interface{}) []byte {
+	switch v := value.(type) {
+	case int64:
+		line = strconv.AppendInt(line, v, 10)
+		return append(line, 'i')
+	case float64:
+		return append(line, formatFloat(v)...)
+	case bool:
+		return strconv.AppendBool(line, v)
+	case string:
+		line = append(line, '"')
+		line = append(line, influxStringEscaper.Replace(v)...)
+		return append(line, '"')
+	}
+	return line
+}
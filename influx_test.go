@@ -0,0 +1,56 @@
+package kontaktparser
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInfluxWriter(t *testing.T) {
+	buffer := bytes.Buffer{}
+	writer := NewInfluxWriter(&buffer)
+	assert.Nil(t, writer.Write(exportRecord(parseFixture(t, frameFixtures[KontaktPlain], false))))
+	assert.Nil(t, writer.Write(exportRecord(parseFixture(t, frameFixtures[EddystoneTLM], false))))
+
+	assert.Equal(t, "kontakt_plain,id=kontakt:abcdef,mac=AA:BB:CC:DD:EE:FF,receiver=gw\\ 1 "+
+		"rssi=-60i,device_model=6i,model_name=\"Smart Beacon 3\",firmware=\"1.15\",battery_level=100i,"+
+		"tx_power=4i,unique_id=\"abcdef\" 1600000000000000005\n"+
+		"eddystone_tlm,id=mac:AA:BB:CC:DD:EE:FF,mac=AA:BB:CC:DD:EE:FF,receiver=gw\\ 1 "+
+		"rssi=-60i,battery_voltage=384i,temperature=5.25,advertisement_count=256i,"+
+		"time_since_power_on=6553.6 1600000000000000005\n", buffer.String())
+}
+
+func TestInfluxWriterAllFrames(t *testing.T) {
+	buffer := bytes.Buffer{}
+	writer := NewInfluxWriter(&buffer)
+	parsers := allFixtures(t)
+	for _, parser := range parsers {
+		assert.Nil(t, writer.Write(exportRecord(parser)))
+	}
+	lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+	assert.Len(t, lines, len(parsers))
+	for i, line := range lines {
+		assert.True(t, strings.HasPrefix(line, parsers[i].DetectedType.String()+","), line)
+	}
+}
+
+func TestInfluxWriterEscaping(t *testing.T) {
+	parser := parseFixture(t, scanResponseFixture, true)
+	parser.Parsed.(*KontaktIOScanResponse).Name = `say "hi", \o/`
+	record := exportRecord(parser)
+	record.ReceiverID = "gw=1,hall"
+
+	buffer := bytes.Buffer{}
+	assert.Nil(t, NewInfluxWriter(&buffer).Write(record))
+	assert.Contains(t, buffer.String(), `receiver=gw\=1\,hall `)
+	assert.Contains(t, buffer.String(), `name="say \"hi\", \\o/"`)
+}
+
+func TestInfluxWriterNotExportable(t *testing.T) {
+	buffer := bytes.Buffer{}
+	writer := NewInfluxWriter(&buffer)
+	assert.Equal(t, ErrNotExportable, writer.Write(ParsedRecord{Err: ErrInvalidPreamble}))
+	assert.Empty(t, buffer.String())
+}
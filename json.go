@@ -0,0 +1,737 @@
+package kontaktparser
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidFrameType = errors.New("invalid frame type")
+)
+
+// MarshalText encodes type as its name, ErrInvalidFrameType is returned for values without name
+func (t DetectedType) MarshalText() ([]byte, error) {
+	name, ok := detectedTypeNames[t]
+	if !ok {
+		return nil, ErrInvalidFrameType
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText decodes type from its name
+func (t *DetectedType) UnmarshalText(text []byte) error {
+	for typ, name := range detectedTypeNames {
+		if name == string(text) {
+			*t = typ
+			return nil
+		}
+	}
+	return ErrInvalidFrameType
+}
+
+// MarshalText encodes PID as its name
+func (pid TelemetryPID) MarshalText() ([]byte, error) {
+	return []byte(pid.String()), nil
+}
+
+// UnmarshalText decodes PID from its name or 0x00 form
+func (pid *TelemetryPID) UnmarshalText(text []byte) error {
+	for p, name := range telemetryPIDNames {
+		if name == string(text) {
+			*pid = p
+			return nil
+		}
+	}
+	if !strings.HasPrefix(string(text), "0x") {
+		return ErrInvalidTelemetryPID
+	}
+	value, err := strconv.ParseUint(string(text[2:]), 16, 8)
+	if err != nil {
+		return ErrInvalidTelemetryPID
+	}
+	*pid = TelemetryPID(value)
+	return nil
+}
+
+// hexBytes is encoded in JSON as a hex string
+type hexBytes []byte
+
+func (b hexBytes) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(b)), nil
+}
+
+func (b *hexBytes) UnmarshalText(text []byte) error {
+	decoded, err := hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// FrameType returns DetectedType matching parsed frame (Parser.Parsed)
+func FrameType(parsed interface{}) DetectedType {
+	switch parsed.(type) {
+	case *IBeaconAdvertisement:
+		return IBeacon
+	case *KontaktIOScanResponse:
+		return KontaktScanResponse
+	case *KontaktPlainAdvertisement:
+		return KontaktPlain
+	case *KontaktShuffledAdvertisement:
+		return KontaktShuffled
+	case *KontaktTelemetryAdvertisement:
+		return KontaktTelemetry
+	case *KontaktLocationAdvertisement:
+		return KontaktLocation
+	case *EddystoneUIDPacket:
+		return EddystoneUID
+	case *EddystoneURLPacket:
+		return EddystoneURL
+	case *EddystonePlainTLMPacket:
+		return EddystoneTLM
+	case *EddystoneEncryptedTLMPacket:
+		return EddystoneETLM
+	case *EddystoneEIDPacket:
+		return EddystoneEID
+	case *EddystoneUnknownPacket:
+		return EddystoneUnknown
+	}
+	return Unknown
+}
+
+// wireFrame is a representation of a frame shared by JSON and CBOR encodings
+type wireFrame interface {
+	frame() interface{}
+	detectedType() DetectedType
+}
+
+// toWire converts parsed frame to its wire representation
+func toWire(parsed interface{}) (wireFrame, error) {
+	switch p := parsed.(type) {
+	case *IBeaconAdvertisement:
+		return newIBeaconWire(p), nil
+	case *KontaktIOScanResponse:
+		return newScanResponseWire(p), nil
+	case *KontaktPlainAdvertisement:
+		return newPlainWire(p), nil
+	case *KontaktShuffledAdvertisement:
+		return newShuffledWire(p), nil
+	case *KontaktTelemetryAdvertisement:
+		return newTelemetryWire(p), nil
+	case *KontaktLocationAdvertisement:
+		return newLocationWire(p), nil
+	case *EddystoneUIDPacket:
+		return newEddystoneUIDWire(p), nil
+	case *EddystoneURLPacket:
+		return newEddystoneURLWire(p), nil
+	case *EddystonePlainTLMPacket:
+		return newEddystoneTLMWire(p), nil
+	case *EddystoneEncryptedTLMPacket:
+		return newEddystoneETLMWire(p), nil
+	case *EddystoneEIDPacket:
+		return newEddystoneEIDWire(p), nil
+	case *EddystoneUnknownPacket:
+		return newEddystoneUnknownWire(p), nil
+	}
+	return nil, ErrInvalidFrameType
+}
+
+// emptyWire creates empty wire representation of given type
+func emptyWire(typ DetectedType) (wireFrame, error) {
+	switch typ {
+	case IBeacon:
+		return &ibeaconWire{Type: typ}, nil
+	case KontaktScanResponse:
+		return &scanResponseWire{Type: typ}, nil
+	case KontaktPlain:
+		return &plainWire{Type: typ}, nil
+	case KontaktShuffled:
+		return &shuffledWire{Type: typ}, nil
+	case KontaktTelemetry:
+		return &telemetryWire{Type: typ}, nil
+	case KontaktLocation:
+		return &locationWire{Type: typ}, nil
+	case EddystoneUID:
+		return &eddystoneUIDWire{Type: typ}, nil
+	case EddystoneURL:
+		return &eddystoneURLWire{Type: typ}, nil
+	case EddystoneTLM:
+		return &eddystoneTLMWire{Type: typ}, nil
+	case EddystoneETLM:
+		return &eddystoneETLMWire{Type: typ}, nil
+	case EddystoneEID:
+		return &eddystoneEIDWire{Type: typ}, nil
+	case EddystoneUnknown:
+		return &eddystoneUnknownWire{Type: typ}, nil
+	}
+	return nil, ErrInvalidFrameType
+}
+
+// UnmarshalFrame decodes JSON encoded frame into a typed frame, selected by "type" discriminator.
+// Result has the same type as Parser.Parsed would have.
+func UnmarshalFrame(data []byte) (interface{}, error) {
+	envelope := struct {
+		Type DetectedType `json:"type"`
+	}{}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, err
+	}
+	wire, err := emptyWire(envelope.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, wire); err != nil {
+		return nil, err
+	}
+	return wire.frame(), nil
+}
+
+func marshalJSON(parsed interface{}) ([]byte, error) {
+	wire, err := toWire(parsed)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(wire)
+}
+
+func unmarshalJSON(data []byte, typ DetectedType) (interface{}, error) {
+	wire, _ := emptyWire(typ)
+	if err := json.Unmarshal(data, wire); err != nil {
+		return nil, err
+	}
+	if wire.detectedType() != typ {
+		return nil, ErrInvalidFrameType
+	}
+	return wire.frame(), nil
+}
+
+// Wire structs are tagged for both JSON and CBOR. CBOR uses integer keys, 0 is always the frame type.
+
+type ibeaconWire struct {
+	Type           DetectedType `json:"type" cbor:"0,keyasint"`
+	CalibratedRssi int8         `json:"calibrated_rssi" cbor:"1,keyasint"`
+	ProximityUUID  uuid.UUID    `json:"proximity_uuid" cbor:"2,keyasint"`
+	Major          uint16       `json:"major" cbor:"3,keyasint"`
+	Minor          uint16       `json:"minor" cbor:"4,keyasint"`
+}
+
+func newIBeaconWire(a *IBeaconAdvertisement) *ibeaconWire {
+	return &ibeaconWire{
+		Type:           IBeacon,
+		CalibratedRssi: a.CalibratedRssi,
+		ProximityUUID:  a.ProximityUUID,
+		Major:          a.Major,
+		Minor:          a.Minor,
+	}
+}
+
+func (w *ibeaconWire) frame() interface{} {
+	return &IBeaconAdvertisement{
+		CalibratedRssi: w.CalibratedRssi,
+		ProximityUUID:  w.ProximityUUID,
+		Major:          w.Major,
+		Minor:          w.Minor,
+	}
+}
+
+func (w *ibeaconWire) detectedType() DetectedType {
+	return w.Type
+}
+
+func (a IBeaconAdvertisement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&a)
+}
+
+func (a *IBeaconAdvertisement) UnmarshalJSON(data []byte) error {
+	frame, err := unmarshalJSON(data, IBeacon)
+	if err != nil {
+		return err
+	}
+	*a = *frame.(*IBeaconAdvertisement)
+	return nil
+}
+
+type scanResponseWire struct {
+	Type            DetectedType `json:"type" cbor:"0,keyasint"`
+	Name            string       `json:"name" cbor:"1,keyasint"`
+	HasName         bool         `json:"has_name" cbor:"2,keyasint"`
+	TxPower         int8         `json:"tx_power" cbor:"3,keyasint"`
+	HasTxPower      bool         `json:"has_tx_power" cbor:"4,keyasint"`
+	Firmware        string       `json:"firmware" cbor:"5,keyasint"`
+	BatteryLevel    uint8        `json:"battery_level" cbor:"6,keyasint"`
+	UniqueID        string       `json:"unique_id" cbor:"7,keyasint"`
+	HasIdentifier   bool         `json:"has_identifier" cbor:"8,keyasint"`
+	ShuffledIBeacon *ibeaconWire `json:"shuffled_ibeacon,omitempty" cbor:"9,keyasint,omitempty"`
+}
+
+func newScanResponseWire(r *KontaktIOScanResponse) *scanResponseWire {
+	w := &scanResponseWire{
+		Type:          KontaktScanResponse,
+		Name:          r.Name,
+		HasName:       r.HasName,
+		TxPower:       r.TxPower,
+		HasTxPower:    r.HasTxPower,
+		Firmware:      r.Firmware,
+		BatteryLevel:  r.BatteryLevel,
+		UniqueID:      r.UniqueID,
+		HasIdentifier: r.HasIdentifier,
+	}
+	if r.ShuffledIBeacon != (IBeaconAdvertisement{}) {
+		w.ShuffledIBeacon = newIBeaconWire(&r.ShuffledIBeacon)
+	}
+	return w
+}
+
+func (w *scanResponseWire) frame() interface{} {
+	r := &KontaktIOScanResponse{
+		Name:          w.Name,
+		HasName:       w.HasName,
+		TxPower:       w.TxPower,
+		HasTxPower:    w.HasTxPower,
+		Firmware:      w.Firmware,
+		BatteryLevel:  w.BatteryLevel,
+		UniqueID:      w.UniqueID,
+		HasIdentifier: w.HasIdentifier,
+	}
+	if w.ShuffledIBeacon != nil {
+		r.ShuffledIBeacon = *w.ShuffledIBeacon.frame().(*IBeaconAdvertisement)
+	}
+	return r
+}
+
+func (w *scanResponseWire) detectedType() DetectedType {
+	return w.Type
+}
+
+func (r KontaktIOScanResponse) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&r)
+}
+
+func (r *KontaktIOScanResponse) UnmarshalJSON(data []byte) error {
+	frame, err := unmarshalJSON(data, KontaktScanResponse)
+	if err != nil {
+		return err
+	}
+	*r = *frame.(*KontaktIOScanResponse)
+	return nil
+}
+
+type plainWire struct {
+	Type          DetectedType `json:"type" cbor:"0,keyasint"`
+	DeviceModel   uint8        `json:"device_model" cbor:"1,keyasint"`
+	FirmwareMajor uint8        `json:"firmware_major" cbor:"2,keyasint"`
+	FirmwareMinor uint8        `json:"firmware_minor" cbor:"3,keyasint"`
+	BatteryLevel  uint8        `json:"battery_level" cbor:"4,keyasint"`
+	TxPower       int8         `json:"tx_power" cbor:"5,keyasint"`
+	UniqueID      string       `json:"unique_id" cbor:"6,keyasint"`
+}
+
+func newPlainWire(a *KontaktPlainAdvertisement) *plainWire {
+	return &plainWire{
+		Type:          KontaktPlain,
+		DeviceModel:   a.DeviceModel,
+		FirmwareMajor: a.FirmwareMajor,
+		FirmwareMinor: a.FirmwareMinor,
+		BatteryLevel:  a.BatteryLevel,
+		TxPower:       a.TxPower,
+		UniqueID:      a.UniqueID,
+	}
+}
+
+func (w *plainWire) frame() interface{} {
+	return &KontaktPlainAdvertisement{
+		DeviceModel:   w.DeviceModel,
+		FirmwareMajor: w.FirmwareMajor,
+		FirmwareMinor: w.FirmwareMinor,
+		BatteryLevel:  w.BatteryLevel,
+		TxPower:       w.TxPower,
+		UniqueID:      w.UniqueID,
+	}
+}
+
+func (w *plainWire) detectedType() DetectedType {
+	return w.Type
+}
+
+func (a KontaktPlainAdvertisement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&a)
+}
+
+func (a *KontaktPlainAdvertisement) UnmarshalJSON(data []byte) error {
+	frame, err := unmarshalJSON(data, KontaktPlain)
+	if err != nil {
+		return err
+	}
+	*a = *frame.(*KontaktPlainAdvertisement)
+	return nil
+}
+
+type shuffledWire struct {
+	Type                DetectedType `json:"type" cbor:"0,keyasint"`
+	DeviceModel         uint8        `json:"device_model" cbor:"1,keyasint"`
+	FirmwareMajor       uint8        `json:"firmware_major" cbor:"2,keyasint"`
+	FirmwareMinor       uint8        `json:"firmware_minor" cbor:"3,keyasint"`
+	BatteryLevel        uint8        `json:"battery_level" cbor:"4,keyasint"`
+	TxPower             int8         `json:"tx_power" cbor:"5,keyasint"`
+	EddystoneNamespace  hexBytes     `json:"eddystone_namespace" cbor:"6,keyasint"`
+	EddystoneInstanceID hexBytes     `json:"eddystone_instance_id" cbor:"7,keyasint"`
+}
+
+func newShuffledWire(a *KontaktShuffledAdvertisement) *shuffledWire {
+	return &shuffledWire{
+		Type:                KontaktShuffled,
+		DeviceModel:         a.DeviceModel,
+		FirmwareMajor:       a.FirmwareMajor,
+		FirmwareMinor:       a.FirmwareMinor,
+		BatteryLevel:        a.BatteryLevel,
+		TxPower:             a.TxPower,
+		EddystoneNamespace:  a.EddystoneNamespace,
+		EddystoneInstanceID: a.EddystoneInstanceID,
+	}
+}
+
+func (w *shuffledWire) frame() interface{} {
+	return &KontaktShuffledAdvertisement{
+		DeviceModel:         w.DeviceModel,
+		FirmwareMajor:       w.FirmwareMajor,
+		FirmwareMinor:       w.FirmwareMinor,
+		BatteryLevel:        w.BatteryLevel,
+		TxPower:             w.TxPower,
+		EddystoneNamespace:  w.EddystoneNamespace,
+		EddystoneInstanceID: w.EddystoneInstanceID,
+	}
+}
+
+func (w *shuffledWire) detectedType() DetectedType {
+	return w.Type
+}
+
+func (a KontaktShuffledAdvertisement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&a)
+}
+
+func (a *KontaktShuffledAdvertisement) UnmarshalJSON(data []byte) error {
+	frame, err := unmarshalJSON(data, KontaktShuffled)
+	if err != nil {
+		return err
+	}
+	*a = *frame.(*KontaktShuffledAdvertisement)
+	return nil
+}
+
+type locationWire struct {
+	Type        DetectedType `json:"type" cbor:"0,keyasint"`
+	TxPower     int8         `json:"tx_power" cbor:"1,keyasint"`
+	BleChannel  uint8        `json:"ble_channel" cbor:"2,keyasint"`
+	DeviceModel uint8        `json:"device_model" cbor:"3,keyasint"`
+	Flags       uint8        `json:"flags" cbor:"4,keyasint"`
+	UniqueID    string       `json:"unique_id" cbor:"5,keyasint"`
+}
+
+func newLocationWire(a *KontaktLocationAdvertisement) *locationWire {
+	return &locationWire{
+		Type:        KontaktLocation,
+		TxPower:     a.TxPower,
+		BleChannel:  a.BleChannel,
+		DeviceModel: a.DeviceModel,
+		Flags:       a.Flags,
+		UniqueID:    a.UniqueID,
+	}
+}
+
+func (w *locationWire) frame() interface{} {
+	return &KontaktLocationAdvertisement{
+		TxPower:     w.TxPower,
+		BleChannel:  w.BleChannel,
+		DeviceModel: w.DeviceModel,
+		Flags:       w.Flags,
+		UniqueID:    w.UniqueID,
+	}
+}
+
+func (w *locationWire) detectedType() DetectedType {
+	return w.Type
+}
+
+func (a KontaktLocationAdvertisement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&a)
+}
+
+func (a *KontaktLocationAdvertisement) UnmarshalJSON(data []byte) error {
+	frame, err := unmarshalJSON(data, KontaktLocation)
+	if err != nil {
+		return err
+	}
+	*a = *frame.(*KontaktLocationAdvertisement)
+	return nil
+}
+
+// telemetryValueWire is encoded in CBOR as [pid, value] array
+type telemetryValueWire struct {
+	_     struct{}     `cbor:",toarray"`
+	PID   TelemetryPID `json:"pid"`
+	Value hexBytes     `json:"value"`
+}
+
+func (v KontaktTelemetryValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(telemetryValueWire{PID: v.PID, Value: v.Value})
+}
+
+func (v *KontaktTelemetryValue) UnmarshalJSON(data []byte) error {
+	w := telemetryValueWire{}
+	if err := json.Unmarshal(data, &w); err != nil {
+		return err
+	}
+	*v = KontaktTelemetryValue{PID: w.PID, Value: w.Value}
+	return nil
+}
+
+type telemetryWire struct {
+	Type   DetectedType         `json:"type" cbor:"0,keyasint"`
+	Fields []telemetryValueWire `json:"fields" cbor:"1,keyasint"`
+}
+
+func newTelemetryWire(a *KontaktTelemetryAdvertisement) *telemetryWire {
+	fields := make([]telemetryValueWire, len(a.Fields))
+	for i, field := range a.Fields {
+		fields[i] = telemetryValueWire{PID: field.PID, Value: field.Value}
+	}
+	return &telemetryWire{Type: KontaktTelemetry, Fields: fields}
+}
+
+func (w *telemetryWire) frame() interface{} {
+	fields := make([]KontaktTelemetryValue, len(w.Fields))
+	for i, field := range w.Fields {
+		fields[i] = KontaktTelemetryValue{PID: field.PID, Value: field.Value}
+	}
+	return &KontaktTelemetryAdvertisement{Fields: fields}
+}
+
+func (w *telemetryWire) detectedType() DetectedType {
+	return w.Type
+}
+
+func (a KontaktTelemetryAdvertisement) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&a)
+}
+
+func (a *KontaktTelemetryAdvertisement) UnmarshalJSON(data []byte) error {
+	frame, err := unmarshalJSON(data, KontaktTelemetry)
+	if err != nil {
+		return err
+	}
+	*a = *frame.(*KontaktTelemetryAdvertisement)
+	return nil
+}
+
+type eddystoneUIDWire struct {
+	Type       DetectedType `json:"type" cbor:"0,keyasint"`
+	TxPower0M  int8         `json:"tx_power_0m" cbor:"1,keyasint"`
+	Namespace  hexBytes     `json:"namespace" cbor:"2,keyasint"`
+	InstanceID hexBytes     `json:"instance_id" cbor:"3,keyasint"`
+}
+
+func newEddystoneUIDWire(p *EddystoneUIDPacket) *eddystoneUIDWire {
+	return &eddystoneUIDWire{
+		Type:       EddystoneUID,
+		TxPower0M:  p.TxPower0M,
+		Namespace:  p.Namespace,
+		InstanceID: p.InstanceId,
+	}
+}
+
+func (w *eddystoneUIDWire) frame() interface{} {
+	return &EddystoneUIDPacket{TxPower0M: w.TxPower0M, Namespace: w.Namespace, InstanceId: w.InstanceID}
+}
+
+func (w *eddystoneUIDWire) detectedType() DetectedType {
+	return w.Type
+}
+
+func (p EddystoneUIDPacket) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&p)
+}
+
+func (p *EddystoneUIDPacket) UnmarshalJSON(data []byte) error {
+	frame, err := unmarshalJSON(data, EddystoneUID)
+	if err != nil {
+		return err
+	}
+	*p = *frame.(*EddystoneUIDPacket)
+	return nil
+}
+
+type eddystoneURLWire struct {
+	Type      DetectedType `json:"type" cbor:"0,keyasint"`
+	TxPower0M int8         `json:"tx_power_0m" cbor:"1,keyasint"`
+	URL       string       `json:"url" cbor:"2,keyasint"`
+}
+
+func newEddystoneURLWire(p *EddystoneURLPacket) *eddystoneURLWire {
+	return &eddystoneURLWire{Type: EddystoneURL, TxPower0M: p.TxPower0M, URL: p.URL}
+}
+
+func (w *eddystoneURLWire) frame() interface{} {
+	return &EddystoneURLPacket{TxPower0M: w.TxPower0M, URL: w.URL}
+}
+
+func (w *eddystoneURLWire) detectedType() DetectedType {
+	return w.Type
+}
+
+func (p EddystoneURLPacket) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&p)
+}
+
+func (p *EddystoneURLPacket) UnmarshalJSON(data []byte) error {
+	frame, err := unmarshalJSON(data, EddystoneURL)
+	if err != nil {
+		return err
+	}
+	*p = *frame.(*EddystoneURLPacket)
+	return nil
+}
+
+type eddystoneTLMWire struct {
+	Type               DetectedType `json:"type" cbor:"0,keyasint"`
+	BatteryVoltage     uint16       `json:"battery_voltage" cbor:"1,keyasint"`
+	Temperature        float64      `json:"temperature" cbor:"2,keyasint"`
+	AdvertisementCount uint32       `json:"advertisement_count" cbor:"3,keyasint"`
+	TimeSincePowerOn   float64      `json:"time_since_power_on" cbor:"4,keyasint"`
+}
+
+func newEddystoneTLMWire(p *EddystonePlainTLMPacket) *eddystoneTLMWire {
+	return &eddystoneTLMWire{
+		Type:               EddystoneTLM,
+		BatteryVoltage:     p.BatteryVoltage,
+		Temperature:        p.Temperature,
+		AdvertisementCount: p.AdvertisementCount,
+		TimeSincePowerOn:   p.TimeSincePowerOn,
+	}
+}
+
+func (w *eddystoneTLMWire) frame() interface{} {
+	return &EddystonePlainTLMPacket{
+		BatteryVoltage:     w.BatteryVoltage,
+		Temperature:        w.Temperature,
+		AdvertisementCount: w.AdvertisementCount,
+		TimeSincePowerOn:   w.TimeSincePowerOn,
+	}
+}
+
+func (w *eddystoneTLMWire) detectedType() DetectedType {
+	return w.Type
+}
+
+func (p EddystonePlainTLMPacket) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&p)
+}
+
+func (p *EddystonePlainTLMPacket) UnmarshalJSON(data []byte) error {
+	frame, err := unmarshalJSON(data, EddystoneTLM)
+	if err != nil {
+		return err
+	}
+	*p = *frame.(*EddystonePlainTLMPacket)
+	return nil
+}
+
+type eddystoneETLMWire struct {
+	Type      DetectedType `json:"type" cbor:"0,keyasint"`
+	Telemetry hexBytes     `json:"telemetry" cbor:"1,keyasint"`
+	Salt      hexBytes     `json:"salt" cbor:"2,keyasint"`
+	MIC       hexBytes     `json:"mic" cbor:"3,keyasint"`
+}
+
+func newEddystoneETLMWire(p *EddystoneEncryptedTLMPacket) *eddystoneETLMWire {
+	return &eddystoneETLMWire{Type: EddystoneETLM, Telemetry: p.Telemetry, Salt: p.Salt, MIC: p.MIC}
+}
+
+func (w *eddystoneETLMWire) frame() interface{} {
+	return &EddystoneEncryptedTLMPacket{Telemetry: w.Telemetry, Salt: w.Salt, MIC: w.MIC}
+}
+
+func (w *eddystoneETLMWire) detectedType() DetectedType {
+	return w.Type
+}
+
+func (p EddystoneEncryptedTLMPacket) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&p)
+}
+
+func (p *EddystoneEncryptedTLMPacket) UnmarshalJSON(data []byte) error {
+	frame, err := unmarshalJSON(data, EddystoneETLM)
+	if err != nil {
+		return err
+	}
+	*p = *frame.(*EddystoneEncryptedTLMPacket)
+	return nil
+}
+
+type eddystoneEIDWire struct {
+	Type      DetectedType `json:"type" cbor:"0,keyasint"`
+	TxPower0M int8         `json:"tx_power_0m" cbor:"1,keyasint"`
+	EID       hexBytes     `json:"eid" cbor:"2,keyasint"`
+}
+
+func newEddystoneEIDWire(p *EddystoneEIDPacket) *eddystoneEIDWire {
+	return &eddystoneEIDWire{Type: EddystoneEID, TxPower0M: p.TxPower0M, EID: p.EID}
+}
+
+func (w *eddystoneEIDWire) frame() interface{} {
+	return &EddystoneEIDPacket{TxPower0M: w.TxPower0M, EID: w.EID}
+}
+
+func (w *eddystoneEIDWire) detectedType() DetectedType {
+	return w.Type
+}
+
+func (p EddystoneEIDPacket) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&p)
+}
+
+func (p *EddystoneEIDPacket) UnmarshalJSON(data []byte) error {
+	frame, err := unmarshalJSON(data, EddystoneEID)
+	if err != nil {
+		return err
+	}
+	*p = *frame.(*EddystoneEIDPacket)
+	return nil
+}
+
+type eddystoneUnknownWire struct {
+	Type      DetectedType `json:"type" cbor:"0,keyasint"`
+	FrameType byte         `json:"frame_type" cbor:"1,keyasint"`
+	Payload   hexBytes     `json:"payload" cbor:"2,keyasint"`
+}
+
+func newEddystoneUnknownWire(p *EddystoneUnknownPacket) *eddystoneUnknownWire {
+	return &eddystoneUnknownWire{Type: EddystoneUnknown, FrameType: p.FrameType, Payload: p.Payload}
+}
+
+func (w *eddystoneUnknownWire) frame() interface{} {
+	return &EddystoneUnknownPacket{FrameType: w.FrameType, Payload: w.Payload}
+}
+
+func (w *eddystoneUnknownWire) detectedType() DetectedType {
+	return w.Type
+}
+
+func (p EddystoneUnknownPacket) MarshalJSON() ([]byte, error) {
+	return marshalJSON(&p)
+}
+
+func (p *EddystoneUnknownPacket) UnmarshalJSON(data []byte) error {
+	frame, err := unmarshalJSON(data, EddystoneUnknown)
+	if err != nil {
+		return err
+	}
+	*p = *frame.(*EddystoneUnknownPacket)
+	return nil
+}
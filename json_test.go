@@ -0,0 +1,132 @@
+package kontaktparser
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// frameFixtures are advertisements of every supported frame type
+var frameFixtures = map[DetectedType]string{
+	IBeacon:          "1AFF4C000215F7826DA64FA24E988024BC5B71E0893E01020304B3",
+	KontaktPlain:     "0F166AFE0206010F6404616263646566",
+	KontaktShuffled:  "19166AFE0106010F640401020304050607080910111213141516",
+	KontaktTelemetry: "0C166AFE03020A640411065BA0",
+	KontaktLocation:  "0E166AFE05F4250A01414243444546",
+	EddystoneUID:     "1716AAFE0004010203040506070809000102030405060000",
+	EddystoneURL:     "0B16AAFE100403746573740C",
+	EddystoneTLM:     "1116AAFE2000018005400000010000010000",
+	EddystoneETLM:    "1516AAFE20010102030405060708090A0B0C01021112",
+	EddystoneEID:     "0D16AAFE30045152535455565758",
+	EddystoneUnknown: "0616AAFE400102",
+}
+
+const scanResponseFixture = "080961626364656667020A040A160DD061626364040264"
+
+func parseFixture(t *testing.T, dataHex string, scanResponse bool) Parser {
+	data, err := hex.DecodeString(dataHex)
+	assert.Nil(t, err)
+	parser := New(data)
+	if scanResponse {
+		assert.Nil(t, parser.ParseScanResponse())
+	} else {
+		assert.Nil(t, parser.ParseAdvertisement())
+	}
+	return parser
+}
+
+func allFixtures(t *testing.T) []Parser {
+	parsers := []Parser{parseFixture(t, scanResponseFixture, true)}
+	for typ, dataHex := range frameFixtures {
+		parser := parseFixture(t, dataHex, false)
+		assert.Equal(t, typ, parser.DetectedType)
+		parsers = append(parsers, parser)
+	}
+	return parsers
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	for _, parser := range allFixtures(t) {
+		data, err := json.Marshal(parser.Parsed)
+		assert.Nil(t, err)
+
+		frame, err := UnmarshalFrame(data)
+		assert.Nil(t, err, string(data))
+		assert.Equal(t, parser.Parsed, frame, string(data))
+		assert.Equal(t, parser.DetectedType, FrameType(frame))
+	}
+}
+
+func TestJSONEddystoneUID(t *testing.T) {
+	parser := parseFixture(t, frameFixtures[EddystoneUID], false)
+	data, err := json.Marshal(parser.Parsed)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"type": "eddystone_uid",
+		"tx_power_0m": 4,
+		"namespace": "01020304050607080900",
+		"instance_id": "010203040506"
+	}`, string(data))
+}
+
+func TestJSONTelemetry(t *testing.T) {
+	parser := parseFixture(t, frameFixtures[KontaktTelemetry], false)
+	data, err := json.Marshal(parser.Parsed)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"type": "kontakt_telemetry",
+		"fields": [
+			{"pid": "light_level", "value": "64"},
+			{"pid": "click_info", "value": "065ba0"}
+		]
+	}`, string(data))
+}
+
+func TestJSONIBeacon(t *testing.T) {
+	parser := parseFixture(t, frameFixtures[IBeacon], false)
+	data, err := json.Marshal(parser.Parsed)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"type": "ibeacon",
+		"calibrated_rssi": -77,
+		"proximity_uuid": "f7826da6-4fa2-4e98-8024-bc5b71e0893e",
+		"major": 513,
+		"minor": 1027
+	}`, string(data))
+}
+
+func TestUnmarshalFrameInvalidType(t *testing.T) {
+	_, err := UnmarshalFrame([]byte(`{"type": "something"}`))
+	assert.Equal(t, ErrInvalidFrameType, err)
+
+	_, err = UnmarshalFrame([]byte(`{"type": "unknown"}`))
+	assert.Equal(t, ErrInvalidFrameType, err)
+
+	adv := KontaktPlainAdvertisement{}
+	assert.Equal(t, ErrInvalidFrameType, json.Unmarshal([]byte(`{"type": "ibeacon"}`), &adv))
+}
+
+func TestDetectedTypeText(t *testing.T) {
+	text, err := KontaktPlain.MarshalText()
+	assert.Nil(t, err)
+	assert.Equal(t, "kontakt_plain", string(text))
+
+	_, err = DetectedType(100).MarshalText()
+	assert.Equal(t, ErrInvalidFrameType, err)
+	_, err = json.Marshal(map[DetectedType]int{DetectedType(100): 1})
+	assert.NotNil(t, err)
+}
+
+func TestTelemetryPIDText(t *testing.T) {
+	text, err := Humidity.MarshalText()
+	assert.Nil(t, err)
+	assert.Equal(t, "humidity", string(text))
+
+	pid := TelemetryPID(0)
+	assert.Nil(t, pid.UnmarshalText([]byte("0x1f")))
+	assert.Equal(t, TelemetryPID(0x1F), pid)
+	assert.Equal(t, "0x1f", pid.String())
+	assert.Equal(t, ErrInvalidTelemetryPID, pid.UnmarshalText([]byte("nope")))
+}
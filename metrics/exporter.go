@@ -0,0 +1,300 @@
+// Package metrics exposes Prometheus metrics computed from parsed frames.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+// contentType - Prometheus text exposition format
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// beaconState holds latest values reported by a single beacon
+type beaconState struct {
+	mac         string
+	identity    string
+	lastSeen    time.Time
+	rssi        int8
+	battery     float64
+	temperature float64
+	humidity    float64
+	light       float64
+	has         map[string]bool
+}
+
+func (b *beaconState) set(metric string, target *float64, value float64) {
+	*target = value
+	b.has[metric] = true
+}
+
+// Exporter keeps per-beacon state built from parsed frames it is fed and serves it as Prometheus metrics.
+// Exporter is an http.Handler and is safe for concurrent use.
+type Exporter struct {
+	// Now returns current time, used for seconds since last seen
+	Now func() time.Time
+
+	timeout time.Duration
+	mutex   sync.Mutex
+	beacons map[string]*beaconState
+	frames  map[kontaktparser.DetectedType]uint64
+	errors  map[string]uint64
+	tracker *kontaktparser.Tracker
+}
+
+// NewExporter creates Exporter which drops beacons not seen for longer than timeout, zero timeout keeps
+// beacons forever
+func NewExporter(timeout time.Duration) *Exporter {
+	trackerTimeout := timeout
+	if trackerTimeout == 0 {
+		trackerTimeout = math.MaxInt64
+	}
+	return &Exporter{
+		Now:     time.Now,
+		timeout: timeout,
+		beacons: make(map[string]*beaconState),
+		frames:  make(map[kontaktparser.DetectedType]uint64),
+		errors:  make(map[string]uint64),
+		tracker: kontaktparser.NewTracker(trackerTimeout),
+	}
+}
+
+// Observe updates metrics with parsed record, parsing errors are counted by reason
+func (e *Exporter) Observe(record kontaktparser.ParsedRecord) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if record.Err != nil {
+		e.errors[record.Err.Error()]++
+		return
+	}
+	e.frames[record.DetectedType]++
+	if record.Parsed == nil {
+		return
+	}
+
+	key := record.MAC
+	identity, hasIdentity := kontaktparser.FrameIdentity(record.Parsed)
+	if key == "" {
+		if !hasIdentity {
+			// frame can't be attributed to any beacon
+			return
+		}
+		key = identity
+	}
+	beacon, ok := e.beacons[key]
+	if !ok {
+		beacon = &beaconState{mac: record.MAC, has: make(map[string]bool)}
+		e.beacons[key] = beacon
+	}
+	if hasIdentity {
+		beacon.identity = identity
+	}
+	received := record.Received
+	if received.IsZero() {
+		received = e.Now()
+	}
+	if received.After(beacon.lastSeen) {
+		beacon.lastSeen = received
+	}
+	if record.MAC != "" {
+		beacon.rssi = record.RSSI
+		beacon.has["rssi"] = true
+		e.tracker.Ingest(record.MAC, record.RSSI, received, record.Parsed)
+	}
+	e.update(beacon, record.Parsed)
+}
+
+// update stores sensor values carried by frame
+func (e *Exporter) update(beacon *beaconState, parsed interface{}) {
+	if battery, ok := kontaktparser.BatteryPercent(parsed, e.model(beacon)); ok {
+		beacon.set("battery", &beacon.battery, battery)
+	}
+	switch frame := parsed.(type) {
+	case *kontaktparser.EddystonePlainTLMPacket:
+		if frame.HasTemperature() {
+			beacon.set("temperature", &beacon.temperature, frame.Temperature)
+		}
+	case *kontaktparser.KontaktTelemetryAdvertisement:
+		values := kontaktparser.DecodeTelemetryValues(frame)
+		if temperature, ok := number(values["temperature"]); ok {
+			beacon.set("temperature", &beacon.temperature, temperature)
+		}
+		if light, ok := number(values["light_level"]); ok {
+			beacon.set("light", &beacon.light, light)
+		}
+		if humidity, ok := number(values["humidity"]); ok {
+			beacon.set("humidity", &beacon.humidity, humidity)
+		}
+	}
+}
+
+// number converts telemetry value (see kontaktparser.FieldValuer) to float64
+func number(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// model returns device model of beacon known from its Kontakt.io frames
+func (e *Exporter) model(beacon *beaconState) kontaktparser.DeviceModel {
+	if beacon.mac == "" {
+		return kontaktparser.UnknownModel
+	}
+	device, ok := e.tracker.DeviceByMAC(beacon.mac)
+	if !ok {
+		return kontaktparser.UnknownModel
+	}
+	return device.Model()
+}
+
+// id returns the best identity of beacon, frames from the same MAC are linked by tracker
+func (e *Exporter) id(beacon *beaconState) string {
+	if beacon.mac != "" {
+		if device, ok := e.tracker.DeviceByMAC(beacon.mac); ok {
+			return device.ID
+		}
+	}
+	return beacon.identity
+}
+
+// expire drops beacons not seen for longer than timeout
+func (e *Exporter) expire(now time.Time) {
+	if e.timeout == 0 {
+		return
+	}
+	for key, beacon := range e.beacons {
+		if now.Sub(beacon.lastSeen) > e.timeout {
+			delete(e.beacons, key)
+		}
+	}
+	e.tracker.Expire(now)
+}
+
+// sample is a single metric value with labels
+type sample struct {
+	labels string
+	value  float64
+}
+
+// family is a metric with its samples
+type family struct {
+	name    string
+	help    string
+	typ     string
+	samples []sample
+}
+
+// gather builds metric families sorted by name, samples sorted by labels
+func (e *Exporter) gather() []family {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	now := e.Now()
+	e.expire(now)
+
+	beaconFamilies := []struct {
+		metric string
+		family family
+		value  func(*beaconState) float64
+	}{
+		{"battery", family{name: "kontakt_beacon_battery_percent", help: "Battery level reported by beacon."},
+			func(b *beaconState) float64 { return b.battery }},
+		{"temperature", family{name: "kontakt_beacon_temperature_celsius", help: "Temperature reported by beacon."},
+			func(b *beaconState) float64 { return b.temperature }},
+		{"humidity", family{name: "kontakt_beacon_humidity_percent", help: "Relative humidity reported by beacon."},
+			func(b *beaconState) float64 { return b.humidity }},
+		{"light", family{name: "kontakt_beacon_light_level_percent", help: "Light level reported by beacon."},
+			func(b *beaconState) float64 { return b.light }},
+		{"rssi", family{name: "kontakt_beacon_rssi_dbm", help: "RSSI of the last frame received from beacon."},
+			func(b *beaconState) float64 { return float64(b.rssi) }},
+		{"", family{name: "kontakt_beacon_last_seen_seconds", help: "Seconds since the last frame received from beacon."},
+			func(b *beaconState) float64 { return now.Sub(b.lastSeen).Seconds() }},
+	}
+
+	families := make([]family, 0, len(beaconFamilies)+3)
+	for _, beaconFamily := range beaconFamilies {
+		f := beaconFamily.family
+		f.typ = "gauge"
+		for _, beacon := range e.beacons {
+			if beaconFamily.metric != "" && !beacon.has[beaconFamily.metric] {
+				continue
+			}
+			f.samples = append(f.samples, sample{
+				labels: labels("id", e.id(beacon), "mac", beacon.mac),
+				value:  beaconFamily.value(beacon),
+			})
+		}
+		families = append(families, f)
+	}
+
+	families = append(families, family{
+		name:    "kontakt_beacons",
+		help:    "Number of beacons seen.",
+		typ:     "gauge",
+		samples: []sample{{value: float64(len(e.beacons))}},
+	})
+	frames := family{name: "kontakt_frames_total", help: "Frames received by type.", typ: "counter"}
+	for typ, count := range e.frames {
+		frames.samples = append(frames.samples, sample{labels: labels("type", typ.String()), value: float64(count)})
+	}
+	errors := family{name: "kontakt_parse_errors_total", help: "Frames which couldn't be parsed by reason.", typ: "counter"}
+	for reason, count := range e.errors {
+		errors.samples = append(errors.samples, sample{labels: labels("reason", reason), value: float64(count)})
+	}
+	families = append(families, frames, errors)
+
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].name < families[j].name
+	})
+	for _, f := range families {
+		samples := f.samples
+		sort.Slice(samples, func(i, j int) bool {
+			return samples[i].labels < samples[j].labels
+		})
+	}
+	return families
+}
+
+// ServeHTTP writes metrics in Prometheus text exposition format
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", contentType)
+	out := bufio.NewWriter(w)
+	for _, f := range e.gather() {
+		fmt.Fprintf(out, "# HELP %s %s\n", f.name, f.help)
+		fmt.Fprintf(out, "# TYPE %s %s\n", f.name, f.typ)
+		for _, s := range f.samples {
+			fmt.Fprintf(out, "%s%s %s\n", f.name, s.labels, strconv.FormatFloat(s.value, 'g', -1, 64))
+		}
+	}
+	out.Flush()
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// labels formats label pairs, labels with empty values are omitted
+func labels(pairs ...string) string {
+	parts := make([]string, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			continue
+		}
+		parts = append(parts, pairs[i]+`="`+labelEscaper.Replace(pairs[i+1])+`"`)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
@@ -0,0 +1,130 @@
+package metrics
+
+import (
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+var exporterStart = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func observe(t *testing.T, exporter *Exporter, mac string, rssi int8, offset time.Duration, dataHex string) {
+	data, err := hex.DecodeString(dataHex)
+	assert.Nil(t, err)
+	exporter.Observe(kontaktparser.ParseRecord(kontaktparser.ScanRecord{
+		MAC:      mac,
+		RSSI:     rssi,
+		Received: exporterStart.Add(offset),
+		Data:     data,
+	}))
+}
+
+func scrape(t *testing.T, handler http.Handler) string {
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	response, err := http.Get(server.URL + "/metrics")
+	assert.Nil(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", response.Header.Get("Content-Type"))
+	body, err := ioutil.ReadAll(response.Body)
+	assert.Nil(t, err)
+	return string(body)
+}
+
+func newTestExporter(timeout time.Duration, now time.Duration) *Exporter {
+	exporter := NewExporter(timeout)
+	exporter.Now = func() time.Time {
+		return exporterStart.Add(now)
+	}
+	return exporter
+}
+
+func TestExporter(t *testing.T) {
+	exporter := newTestExporter(time.Minute, 10*time.Second)
+	// Kontakt.io plain and telemetry with light level and 16 bit temperature
+	observe(t, exporter, "F1:A2:B3:C4:D5:E6", -60, 0, "0201060F166AFE0206010F6404616263646566")
+	observe(t, exporter, "F1:A2:B3:C4:D5:E6", -62, time.Second, "0201060E166AFE03020A4B03131A8002125A")
+	// Eddystone UID with TLM
+	observe(t, exporter, "C0:A1:B2:C3:D4:E5", -70, 2*time.Second, "0201061716AAFE0004010203040506070809000102030405060000")
+	observe(t, exporter, "C0:A1:B2:C3:D4:E5", -71, 4*time.Second, "0201061116AAFE2000018005400000010000010000")
+	// telemetry without MAC can't be attributed to any beacon
+	observe(t, exporter, "", 0, 0, "0201060C166AFE03020A640411065BA0")
+	exporter.Observe(kontaktparser.ParsedRecord{Err: kontaktparser.ErrInvalidLength})
+	exporter.Observe(kontaktparser.ParsedRecord{Err: errors.New("bad \"quoted\"\nreason")})
+	observe(t, exporter, "AA:BB:CC:DD:EE:FF", -50, 0, "0201060F166AFE02")
+
+	expected := `# HELP kontakt_beacon_battery_percent Battery level reported by beacon.
+# TYPE kontakt_beacon_battery_percent gauge
+kontakt_beacon_battery_percent{id="eddystone:01020304050607080900:010203040506",mac="C0:A1:B2:C3:D4:E5"} 0
+kontakt_beacon_battery_percent{id="kontakt:abcdef",mac="F1:A2:B3:C4:D5:E6"} 100
+# HELP kontakt_beacon_humidity_percent Relative humidity reported by beacon.
+# TYPE kontakt_beacon_humidity_percent gauge
+kontakt_beacon_humidity_percent{id="kontakt:abcdef",mac="F1:A2:B3:C4:D5:E6"} 90
+# HELP kontakt_beacon_last_seen_seconds Seconds since the last frame received from beacon.
+# TYPE kontakt_beacon_last_seen_seconds gauge
+kontakt_beacon_last_seen_seconds{id="eddystone:01020304050607080900:010203040506",mac="C0:A1:B2:C3:D4:E5"} 6
+kontakt_beacon_last_seen_seconds{id="kontakt:abcdef",mac="F1:A2:B3:C4:D5:E6"} 9
+# HELP kontakt_beacon_light_level_percent Light level reported by beacon.
+# TYPE kontakt_beacon_light_level_percent gauge
+kontakt_beacon_light_level_percent{id="kontakt:abcdef",mac="F1:A2:B3:C4:D5:E6"} 75
+# HELP kontakt_beacon_rssi_dbm RSSI of the last frame received from beacon.
+# TYPE kontakt_beacon_rssi_dbm gauge
+kontakt_beacon_rssi_dbm{id="eddystone:01020304050607080900:010203040506",mac="C0:A1:B2:C3:D4:E5"} -71
+kontakt_beacon_rssi_dbm{id="kontakt:abcdef",mac="F1:A2:B3:C4:D5:E6"} -62
+# HELP kontakt_beacon_temperature_celsius Temperature reported by beacon.
+# TYPE kontakt_beacon_temperature_celsius gauge
+kontakt_beacon_temperature_celsius{id="eddystone:01020304050607080900:010203040506",mac="C0:A1:B2:C3:D4:E5"} 5.25
+kontakt_beacon_temperature_celsius{id="kontakt:abcdef",mac="F1:A2:B3:C4:D5:E6"} 26.5
+# HELP kontakt_beacons Number of beacons seen.
+# TYPE kontakt_beacons gauge
+kontakt_beacons 2
+# HELP kontakt_frames_total Frames received by type.
+# TYPE kontakt_frames_total counter
+kontakt_frames_total{type="eddystone_tlm"} 1
+kontakt_frames_total{type="eddystone_uid"} 1
+kontakt_frames_total{type="kontakt_plain"} 1
+kontakt_frames_total{type="kontakt_telemetry"} 2
+# HELP kontakt_parse_errors_total Frames which couldn't be parsed by reason.
+# TYPE kontakt_parse_errors_total counter
+kontakt_parse_errors_total{reason="EOF"} 1
+kontakt_parse_errors_total{reason="bad \"quoted\"\nreason"} 1
+kontakt_parse_errors_total{reason="packet has invalid length"} 1
+`
+	assert.Equal(t, expected, scrape(t, exporter))
+}
+
+func TestExporterExpiry(t *testing.T) {
+	exporter := newTestExporter(time.Minute, 0)
+	observe(t, exporter, "F1:A2:B3:C4:D5:E6", -60, 0, "0201060F166AFE0206010F6404616263646566")
+	assert.Contains(t, scrape(t, exporter), "kontakt_beacons 1\n")
+
+	exporter.Now = func() time.Time {
+		return exporterStart.Add(2 * time.Minute)
+	}
+	body := scrape(t, exporter)
+	assert.Contains(t, body, "kontakt_beacons 0\n")
+	assert.NotContains(t, body, "kontakt_beacon_rssi_dbm{")
+	assert.Contains(t, body, `kontakt_frames_total{type="kontakt_plain"} 1`)
+
+	forever := newTestExporter(0, 24*time.Hour)
+	observe(t, forever, "F1:A2:B3:C4:D5:E6", -60, 0, "0201060F166AFE0206010F6404616263646566")
+	assert.Contains(t, scrape(t, forever), "kontakt_beacons 1\n")
+}
+
+func TestExporterMountable(t *testing.T) {
+	exporter := newTestExporter(0, 0)
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", exporter)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "# TYPE kontakt_frames_total counter\n")
+}
@@ -0,0 +1,266 @@
+package kontaktparser
+
+import "fmt"
+
+// DeviceModel is an identifier of Kontakt.io hardware model sent in Secure Profile and Location packets
+type DeviceModel uint8
+
+const (
+	// UnknownModel - model identifier not known by parser
+	UnknownModel DeviceModel = 0x00
+	// SmartBeacon - Smart Beacon (SB16-2)
+	SmartBeacon DeviceModel = 0x01
+	// ToughBeacon - Tough Beacon (TB15-1)
+	ToughBeacon DeviceModel = 0x02
+	// USBBeacon - USB Beacon (UB16-2)
+	USBBeacon DeviceModel = 0x03
+	// CardBeacon - Card Beacon (CT16-2)
+	CardBeacon DeviceModel = 0x04
+	// BeaconPro - Beacon Pro (BP16-3)
+	BeaconPro DeviceModel = 0x05
+	// SmartBeacon3 - Smart Beacon (SB18-3)
+	SmartBeacon3 DeviceModel = 0x06
+	// ToughBeacon2 - Tough Beacon (TB18-2)
+	ToughBeacon2 DeviceModel = 0x07
+	// AssetTag - Asset Tag (S18-3)
+	AssetTag DeviceModel = 0x08
+	// CardBeacon2 - Card Beacon (CT18-3)
+	CardBeacon2 DeviceModel = 0x09
+	// Badge - Badge (BG18-3)
+	Badge DeviceModel = 0x0A
+	// BraceletTag - Bracelet Tag (BT18-3)
+	BraceletTag DeviceModel = 0x0B
+)
+
+// BatteryType is a type of power source used by beacon model
+type BatteryType int
+
+const (
+	// UnknownBattery - power source is not known
+	UnknownBattery BatteryType = iota
+	// NoBattery - device is powered externally (e.g. over USB)
+	NoBattery
+	// BatteryCR2032 - CR2032 coin cell
+	BatteryCR2032
+	// BatteryCR2477 - CR2477 coin cell
+	BatteryCR2477
+	// BatteryAA - AA lithium battery
+	BatteryAA
+	// BatteryER34615 - D-size ER34615 lithium-thionyl chloride cell
+	BatteryER34615
+	// BatteryRechargeable - built-in rechargeable Li-Po cell
+	BatteryRechargeable
+)
+
+var batteryTypeNames = map[BatteryType]string{
+	UnknownBattery:      "Unknown",
+	NoBattery:           "None",
+	BatteryCR2032:       "CR2032",
+	BatteryCR2477:       "CR2477",
+	BatteryAA:           "AA",
+	BatteryER34615:      "ER34615",
+	BatteryRechargeable: "Rechargeable",
+}
+
+// String returns name of the battery type
+func (b BatteryType) String() string {
+	if name, ok := batteryTypeNames[b]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+// ModelInfo describes a Kontakt.io hardware model
+type ModelInfo struct {
+	Model DeviceModel
+	// Name is a product name, e.g. "Smart Beacon"
+	Name string
+	// Symbol is a hardware revision symbol, e.g. "SB16-2"
+	Symbol  string
+	Battery BatteryType
+	// TelemetryPIDs lists telemetry fields that model is able to advertise
+	TelemetryPIDs []TelemetryPID
+}
+
+// SupportsTelemetry reports whether model is able to advertise given telemetry field
+func (i ModelInfo) SupportsTelemetry(pid TelemetryPID) bool {
+	for _, supported := range i.TelemetryPIDs {
+		if supported == pid {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	basicTelemetry  = []TelemetryPID{SystemHealth, BatteryLevel, Temperature8Bit}
+	sensorTelemetry = []TelemetryPID{
+		SystemHealth, Accelerometer, Sensors, Acceleration, Movement, DoubleTap, LightLevel,
+		Temperature8Bit, Temperature16Bit, BatteryLevel, UTCTime, MovementInfo,
+	}
+	buttonTelemetry = []TelemetryPID{
+		SystemHealth, Accelerometer, Acceleration, Movement, DoubleTap, Temperature8Bit,
+		BatteryLevel, Click, ClickInfo, UTCTime, MovementInfo,
+	}
+)
+
+// deviceModels is a catalogue of hardware known to the parser
+var deviceModels = map[DeviceModel]ModelInfo{
+	SmartBeacon: {
+		Model: SmartBeacon, Name: "Smart Beacon", Symbol: "SB16-2", Battery: BatteryCR2477,
+		TelemetryPIDs: basicTelemetry,
+	},
+	ToughBeacon: {
+		Model: ToughBeacon, Name: "Tough Beacon", Symbol: "TB15-1", Battery: BatteryER34615,
+		TelemetryPIDs: basicTelemetry,
+	},
+	USBBeacon: {
+		Model: USBBeacon, Name: "USB Beacon", Symbol: "UB16-2", Battery: NoBattery,
+		TelemetryPIDs: basicTelemetry,
+	},
+	CardBeacon: {
+		Model: CardBeacon, Name: "Card Beacon", Symbol: "CT16-2", Battery: BatteryCR2032,
+		TelemetryPIDs: basicTelemetry,
+	},
+	BeaconPro: {
+		Model: BeaconPro, Name: "Beacon Pro", Symbol: "BP16-3", Battery: BatteryAA,
+		TelemetryPIDs: sensorTelemetry,
+	},
+	SmartBeacon3: {
+		Model: SmartBeacon3, Name: "Smart Beacon 3", Symbol: "SB18-3", Battery: BatteryCR2477,
+		TelemetryPIDs: sensorTelemetry,
+	},
+	ToughBeacon2: {
+		Model: ToughBeacon2, Name: "Tough Beacon 2", Symbol: "TB18-2", Battery: BatteryER34615,
+		TelemetryPIDs: sensorTelemetry,
+	},
+	AssetTag: {
+		Model: AssetTag, Name: "Asset Tag", Symbol: "S18-3", Battery: BatteryCR2032,
+		TelemetryPIDs: sensorTelemetry,
+	},
+	CardBeacon2: {
+		Model: CardBeacon2, Name: "Card Beacon 2", Symbol: "CT18-3", Battery: BatteryCR2032,
+		TelemetryPIDs: buttonTelemetry,
+	},
+	Badge: {
+		Model: Badge, Name: "Badge", Symbol: "BG18-3", Battery: BatteryRechargeable,
+		TelemetryPIDs: buttonTelemetry,
+	},
+	BraceletTag: {
+		Model: BraceletTag, Name: "Bracelet Tag", Symbol: "BT18-3", Battery: BatteryRechargeable,
+		TelemetryPIDs: buttonTelemetry,
+	},
+}
+
+// ParseDeviceModel maps raw model identifier from packet to DeviceModel.
+// Identifiers not present in the model catalogue are mapped to UnknownModel.
+func ParseDeviceModel(model uint8) DeviceModel {
+	if _, ok := deviceModels[DeviceModel(model)]; !ok {
+		return UnknownModel
+	}
+	return DeviceModel(model)
+}
+
+// Info returns catalogue entry of the model, false is returned for models not known by parser
+func (m DeviceModel) Info() (ModelInfo, bool) {
+	info, ok := deviceModels[m]
+	return info, ok
+}
+
+// String returns human readable name of the model
+func (m DeviceModel) String() string {
+	if info, ok := deviceModels[m]; ok {
+		return info.Name
+	}
+	return fmt.Sprintf("Unknown (0x%02X)", uint8(m))
+}
+
+// FirmwareReleases maps device models to the newest firmware released for them. Releases aren't part
+// of the catalogue as they change independently of the parser, the map has to be filled by the caller,
+// e.g. from Kontakt.io release notes.
+type FirmwareReleases map[DeviceModel]FirmwareVersion
+
+// IsOutdated reports whether given firmware is older than the latest one released for the model.
+// False is returned for models without known release.
+func (r FirmwareReleases) IsOutdated(model DeviceModel, firmware FirmwareVersion) bool {
+	latest, ok := r[model]
+	if !ok {
+		return false
+	}
+	return firmware.Less(latest)
+}
+
+// AdvertisingChannel is a BLE advertising channel on which Location packet was sent
+type AdvertisingChannel uint8
+
+const (
+	// UnknownChannel - beacon didn't report the channel
+	UnknownChannel AdvertisingChannel = 0
+	// Channel37 - advertising channel 37 (2402 MHz)
+	Channel37 AdvertisingChannel = 37
+	// Channel38 - advertising channel 38 (2426 MHz)
+	Channel38 AdvertisingChannel = 38
+	// Channel39 - advertising channel 39 (2480 MHz)
+	Channel39 AdvertisingChannel = 39
+)
+
+// ParseAdvertisingChannel maps raw channel byte to AdvertisingChannel, values other than 37, 38 or 39 are
+// mapped to UnknownChannel
+func ParseAdvertisingChannel(channel uint8) AdvertisingChannel {
+	switch AdvertisingChannel(channel) {
+	case Channel37, Channel38, Channel39:
+		return AdvertisingChannel(channel)
+	}
+	return UnknownChannel
+}
+
+// LocationFlags is a bit field sent in Kontakt.io Location packet
+type LocationFlags uint8
+
+const (
+	locationFlagShuffling LocationFlags = 0x01
+)
+
+// ShufflingEnabled reports whether beacon has Secure Shuffling turned on
+func (f LocationFlags) ShufflingEnabled() bool {
+	return f&locationFlagShuffling != 0
+}
+
+// Model returns decoded DeviceModel
+func (a *KontaktPlainAdvertisement) Model() DeviceModel {
+	return ParseDeviceModel(a.DeviceModel)
+}
+
+// Model returns decoded DeviceModel
+func (a *KontaktShuffledAdvertisement) Model() DeviceModel {
+	return ParseDeviceModel(a.DeviceModel)
+}
+
+// Model returns decoded DeviceModel
+func (a *KontaktLocationAdvertisement) Model() DeviceModel {
+	return ParseDeviceModel(a.DeviceModel)
+}
+
+// Channel returns decoded BLE advertising channel
+func (a *KontaktLocationAdvertisement) Channel() AdvertisingChannel {
+	return ParseAdvertisingChannel(a.BleChannel)
+}
+
+// LocationFlags returns decoded Flags field
+func (a *KontaktLocationAdvertisement) LocationFlags() LocationFlags {
+	return LocationFlags(a.Flags)
+}
+
+// FrameModel returns device model sent in parsed frame (Parser.Parsed). UnknownModel is returned for frames
+// which don't carry model, i.e. other than Kontakt.io plain, shuffled and location frames.
+func FrameModel(parsed interface{}) DeviceModel {
+	switch frame := parsed.(type) {
+	case *KontaktPlainAdvertisement:
+		return frame.Model()
+	case *KontaktShuffledAdvertisement:
+		return frame.Model()
+	case *KontaktLocationAdvertisement:
+		return frame.Model()
+	}
+	return UnknownModel
+}
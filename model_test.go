@@ -0,0 +1,73 @@
+package kontaktparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDeviceModel(t *testing.T) {
+	assert.Equal(t, SmartBeacon, ParseDeviceModel(0x01))
+	assert.Equal(t, BeaconPro, ParseDeviceModel(0x05))
+	assert.Equal(t, UnknownModel, ParseDeviceModel(0xF0))
+}
+
+func TestDeviceModelString(t *testing.T) {
+	assert.Equal(t, "Tough Beacon", ToughBeacon.String())
+	assert.Equal(t, "Unknown (0xF0)", DeviceModel(0xF0).String())
+}
+
+func TestParseAdvertisingChannel(t *testing.T) {
+	assert.Equal(t, Channel37, ParseAdvertisingChannel(37))
+	assert.Equal(t, Channel38, ParseAdvertisingChannel(38))
+	assert.Equal(t, Channel39, ParseAdvertisingChannel(39))
+	assert.Equal(t, UnknownChannel, ParseAdvertisingChannel(12))
+}
+
+func TestLocationFlags(t *testing.T) {
+	assert.True(t, LocationFlags(0x01).ShufflingEnabled())
+	assert.True(t, LocationFlags(0x03).ShufflingEnabled())
+	assert.False(t, LocationFlags(0x00).ShufflingEnabled())
+}
+
+func TestAdvertisementModel(t *testing.T) {
+	plain := KontaktPlainAdvertisement{DeviceModel: 0x06}
+	assert.Equal(t, SmartBeacon3, plain.Model())
+
+	shuffled := KontaktShuffledAdvertisement{DeviceModel: 0x08}
+	assert.Equal(t, AssetTag, shuffled.Model())
+}
+
+func TestFrameModel(t *testing.T) {
+	assert.Equal(t, Badge, FrameModel(&KontaktLocationAdvertisement{DeviceModel: 0x0A}))
+	assert.Equal(t, SmartBeacon3, FrameModel(&KontaktPlainAdvertisement{DeviceModel: 0x06}))
+	assert.Equal(t, SmartBeacon3, FrameModel(&KontaktShuffledAdvertisement{DeviceModel: 0x06}))
+	assert.Equal(t, UnknownModel, FrameModel(&IBeaconAdvertisement{}))
+}
+
+func TestDeviceModelInfo(t *testing.T) {
+	info, ok := BeaconPro.Info()
+	assert.True(t, ok)
+	assert.Equal(t, "Beacon Pro", info.Name)
+	assert.Equal(t, "BP16-3", info.Symbol)
+	assert.Equal(t, BatteryAA, info.Battery)
+	assert.True(t, info.SupportsTelemetry(LightLevel))
+	assert.False(t, info.SupportsTelemetry(Click))
+
+	_, ok = UnknownModel.Info()
+	assert.False(t, ok)
+}
+
+func TestFirmwareReleasesIsOutdated(t *testing.T) {
+	releases := FirmwareReleases{SmartBeacon: {4, 16}}
+	assert.False(t, releases.IsOutdated(SmartBeacon, FirmwareVersion{4, 16}))
+	assert.False(t, releases.IsOutdated(SmartBeacon, FirmwareVersion{5, 0}))
+	assert.True(t, releases.IsOutdated(SmartBeacon, FirmwareVersion{4, 15}))
+	assert.False(t, releases.IsOutdated(BeaconPro, FirmwareVersion{0, 1}))
+	assert.False(t, FirmwareReleases(nil).IsOutdated(SmartBeacon, FirmwareVersion{0, 1}))
+}
+
+func TestBatteryTypeString(t *testing.T) {
+	assert.Equal(t, "CR2477", BatteryCR2477.String())
+	assert.Equal(t, "Unknown", BatteryType(100).String())
+}
@@ -1,13 +1,7 @@
 package kontaktparser
 
 import (
-	"bytes"
-	"encoding/binary"
 	"errors"
-	"fmt"
-	"io"
-
-	"github.com/google/uuid"
 )
 
 type DetectedType int
@@ -37,8 +31,34 @@ const (
 	KontaktTelemetry
 	// KontaktLocation - Kontakt.io Location packet
 	KontaktLocation
+	// EddystoneUnknown - Eddystone packet with frame type or TLM version not supported by parser
+	EddystoneUnknown
 )
 
+var detectedTypeNames = map[DetectedType]string{
+	Unknown:             "unknown",
+	IBeacon:             "ibeacon",
+	EddystoneUID:        "eddystone_uid",
+	EddystoneURL:        "eddystone_url",
+	EddystoneTLM:        "eddystone_tlm",
+	EddystoneEID:        "eddystone_eid",
+	EddystoneETLM:       "eddystone_etlm",
+	KontaktScanResponse: "kontakt_scan_response",
+	KontaktPlain:        "kontakt_plain",
+	KontaktShuffled:     "kontakt_shuffled",
+	KontaktTelemetry:    "kontakt_telemetry",
+	KontaktLocation:     "kontakt_location",
+	EddystoneUnknown:    "eddystone_unknown",
+}
+
+// String returns snake_case name of the type, used e.g. in JSON encoding
+func (t DetectedType) String() string {
+	if name, ok := detectedTypeNames[t]; ok {
+		return name
+	}
+	return detectedTypeNames[Unknown]
+}
+
 var (
 	ibeaconLength = 25
 )
@@ -49,6 +69,7 @@ var (
 	ErrNotImplemented                  = errors.New("packet not supported yet")
 	ErrInvalidLength                   = errors.New("packet has invalid length")
 	ErrInvalidURL                      = errors.New("invalid eddystone url")
+	ErrUnsupportedTLMVersion           = errors.New("unsupported eddystone tlm version")
 )
 
 var (
@@ -63,8 +84,10 @@ var (
 	kontaktScanResponseUUID           = []byte{0x0D, 0xD0}
 )
 
+// Parser parses a single advertisement or scan response. Parser can be reused for subsequent packets with
+// Reset, zero value is a Parser with no data.
 type Parser struct {
-	buf          *bytes.Buffer
+	data         []byte
 	DetectedType DetectedType
 	Flags        byte
 	Parsed       interface{}
@@ -72,257 +95,58 @@ type Parser struct {
 
 func New(adv []byte) Parser {
 	return Parser{
-		buf:          bytes.NewBuffer(adv),
+		data:         adv,
 		DetectedType: Unknown,
 	}
 }
 
-func (p *Parser) ParseScanResponse() error {
-	scanResponse := KontaktIOScanResponse{}
-	for p.buf.Len() > 0 {
-		typ, section, err := p.nextSection()
-		if err != nil {
-			return err
-		}
-		switch typ {
-		case completeNameType:
-			scanResponse.Name = string(section)
-			scanResponse.HasName = true
-		case txPowerType:
-			scanResponse.TxPower = int8(section[0])
-			scanResponse.HasTxPower = true
-		case serviceDataDataType:
-			if !bytes.Equal(section[0:2], kontaktScanResponseUUID) || len(section) != 9 {
-				continue
-			}
-			scanResponse.UniqueID = string(section[2:6])
-			scanResponse.Firmware = fmt.Sprintf("%v.%v", section[6], section[7])
-			scanResponse.BatteryLevel = uint8(section[8])
-			scanResponse.HasIdentifier = true
-		}
-	}
-	if scanResponse.HasName || scanResponse.HasTxPower || scanResponse.HasIdentifier {
-		p.Parsed = &scanResponse
-		p.DetectedType = KontaktScanResponse
-	}
-	return nil
-}
-
-func (p *Parser) ParseAdvertisement() error {
-	for p.buf.Len() > 0 {
-		typ, section, err := p.nextSection()
-		if err != nil {
-			return err
-		}
-		switch typ {
-		case flagsDataType:
-			p.Flags = section[0]
-		case manufacturerDataType:
-			if len(section) != ibeaconLength {
-				continue
-			}
-			if err = p.parseIBeacon(section); err == ErrInvalidPreamble {
-				continue
-			} else if err != nil {
-				return err
-			}
-		case serviceDataDataType:
-			if len(section) < 2 {
-				return io.EOF
-			}
-			uuid := section[0:2]
-			if bytes.Equal(uuid, kontaktUUID) {
-				if err := p.parseKontaktAdv(section); err != nil {
-					return err
-				}
-			} else if bytes.Equal(uuid, eddystoneUUID) {
-				if err := p.parseEddystone(section); err != nil {
-					return err
-				}
-			} else {
-				continue
-			}
-		default:
-			continue
-		}
-	}
-	return nil
+// Reset prepares parser for parsing another advertisement, clearing Flags, DetectedType and Parsed of
+// the previous one. Parser reset with nil data doesn't reference any packet, so it can be kept in sync.Pool.
+func (p *Parser) Reset(adv []byte) {
+	p.data = adv
+	p.DetectedType = Unknown
+	p.Flags = 0
+	p.Parsed = nil
 }
 
-func (p *Parser) nextSection() (byte, []byte, error) {
-	len, err := p.buf.ReadByte()
-	if err != nil {
-		return 0, nil, err
-	}
-	typ, err := p.buf.ReadByte()
-	if err != nil {
-		return 0, nil, err
-	}
-	sectionData := make([]byte, len-1)
-	n, err := p.buf.Read(sectionData)
-	if err != nil {
-		return typ, nil, err
-	}
-	if n != int(len)-1 {
-		return typ, nil, io.EOF
-	}
-	return typ, sectionData, nil
+// ResetScanResponse prepares parser for parsing another scan response with ParseScanResponse.
+// Scan responses don't carry flags, so Flags of the previous packet are cleared as well.
+func (p *Parser) ResetScanResponse(scanResponse []byte) {
+	p.Reset(scanResponse)
 }
 
-func (p *Parser) parseIBeacon(section []byte) error {
-	if !bytes.Equal(section[0:4], ibeaconManufacturerConstData) {
-		return ErrInvalidPreamble
-	}
-	proximity, err := uuid.FromBytes(section[4:20])
-	if err != nil {
-		return err
-	}
-	major := section[20:22]
-	minor := section[22:24]
-	rssi := section[24]
-
-	p.Parsed = &IBeaconAdvertisement{
-		CalibratedRssi: int8(rssi),
-		ProximityUUID:  proximity,
-		Major:          binary.LittleEndian.Uint16(major),
-		Minor:          binary.LittleEndian.Uint16(minor),
-	}
-	p.DetectedType = IBeacon
-	return nil
-}
-
-func (p *Parser) parseKontaktAdv(section []byte) error {
-	if len(section) < 3 {
-		return io.EOF
-	}
-	var err error
-	switch section[2] {
-	case 0x01:
-		err = p.parseKontaktShuffled(section)
-	case 0x02:
-		err = p.parseKontaktPlain(section)
-	case 0x03:
-		err = p.parseKontaktTelemetry(section)
-	case 0x05:
-		err = p.parseKontaktLocation(section)
-	default:
-		return ErrInvalidKontaktPayloadIdentifier
-	}
+func (p *Parser) ParseScanResponse() error {
+	frame := Frame{}
+	err := frame.ParseScanResponse(p.next())
+	p.update(&frame)
 	return err
 }
 
-func (p *Parser) parseKontaktPlain(section []byte) error {
-	if len(section) < 9 {
-		return nil
-	}
-	p.Parsed = &KontaktPlainAdvertisement{
-		DeviceModel:   uint8(section[3]),
-		FirmwareMajor: uint8(section[4]),
-		FirmwareMinor: uint8(section[5]),
-		BatteryLevel:  uint8(section[6]),
-		TxPower:       int8(section[7]),
-		UniqueID:      string(section[8:]),
-	}
-	p.DetectedType = KontaktPlain
-	return nil
-}
-
-func (p *Parser) parseKontaktShuffled(section []byte) error {
-	if len(section) != 23 {
-		return nil
-	}
-	p.Parsed = &KontaktShuffledAdvertisement{
-		DeviceModel:         uint8(section[3]),
-		FirmwareMajor:       uint8(section[4]),
-		FirmwareMinor:       uint8(section[5]),
-		BatteryLevel:        uint8(section[6]),
-		TxPower:             int8(section[7]),
-		EddystoneNamespace:  section[8:18],
-		EddystoneInstanceID: section[18:24],
-	}
-	p.DetectedType = KontaktShuffled
-	return nil
-}
-
-func (p *Parser) parseKontaktTelemetry(section []byte) error {
-	fields := make([]KontaktTelemetryValue, 0)
-	buf := bytes.NewBuffer(section[3:])
-	for buf.Len() != 0 {
-		len, err := buf.ReadByte()
-		if err != nil || buf.Len() < int(len) {
-			return io.EOF
-		}
-		pid, err := buf.ReadByte()
-		if err != nil {
-			return err
-		}
-		value := make([]byte, len-1)
-		if n, err := buf.Read(value); err != nil {
-			return err
-		} else if n != int(len)-1 {
-			return io.EOF
-		}
-		fields = append(fields, KontaktTelemetryValue{
-			PID:   TelemetryPID(pid),
-			Value: value,
-		})
-	}
-	p.Parsed = &KontaktTelemetryAdvertisement{Fields: fields}
-	p.DetectedType = KontaktTelemetry
-	return nil
-}
-
-func (p *Parser) parseKontaktLocation(section []byte) error {
-	if len(section) < 8 {
-		return nil
-	}
-
-	txPower := section[3]
-	bleChannel := section[4]
-	model := section[5]
-	flags := section[6]
-	uniqueID := string(section[7:])
-
-	p.Parsed = &KontaktLocationAdvertisement{
-		TxPower:     int8(txPower),
-		BleChannel:  uint8(bleChannel),
-		DeviceModel: uint8(model),
-		Flags:       uint8(flags),
-		UniqueID:    uniqueID,
+func (p *Parser) ParseAdvertisement() error {
+	frame := Frame{}
+	err := frame.ParseAdvertisement(p.next())
+	if frame.HasFlags {
+		p.Flags = frame.Flags
 	}
-	p.DetectedType = KontaktLocation
-	return nil
+	p.update(&frame)
+	return err
 }
 
-func (p *Parser) parseEddystone(section []byte) error {
-	if len(section) < 3 {
-		return io.EOF
-	}
-	var err error
-	switch section[2] {
-	case 0x00:
-		err = p.parseEddystoneUID(section)
-	case 0x10:
-		err = p.parseEddystoneURL(section)
-	case 0x20:
-		err = p.parseEddystoneTLM(section)
-	case 0x30:
-		err = p.parseEddystoneEID(section)
-	}
-	return err
+// next returns data left to parse, data is consumed by parsing
+func (p *Parser) next() []byte {
+	data := p.data
+	p.data = nil
+	return data
 }
 
-func (p *Parser) parseEddystoneUID(section []byte) error {
-	if len(section) != 22 {
-		return io.EOF
-	}
-	p.Parsed = &EddystoneUIDPacket{
-		TxPower0M:  int8(section[3]),
-		Namespace:  section[4:14],
-		InstanceId: section[14:20],
+// update stores copy of parsed frame, so that it doesn't reference the buffer.
+// Frame detected in sections preceding parsing error is kept.
+func (p *Parser) update(frame *Frame) {
+	if frame.DetectedType == Unknown {
+		return
 	}
-	p.DetectedType = EddystoneUID
-	return nil
+	p.DetectedType = frame.DetectedType
+	p.Parsed = frame.Parsed()
 }
 
 var eddystoneUrlPrefixes = map[byte][]byte{
@@ -348,87 +172,3 @@ var eddystoneUrlReplacements = map[byte][]byte{
 	0x0C: []byte(".biz"),
 	0x0D: []byte(".gov"),
 }
-
-func (p *Parser) parseEddystoneURL(section []byte) error {
-	if len(section) < 6 {
-		return io.EOF
-	}
-	txPower := int8(section[3])
-	url := make([]byte, 0)
-	if prefix, ok := eddystoneUrlPrefixes[section[4]]; ok {
-		url = append(url, prefix...)
-	} else {
-		return ErrInvalidURL
-	}
-	for i := 5; i < len(section); i++ {
-		b := section[i]
-		if b >= 0x20 && b <= 0x7F {
-			url = append(url, b)
-			continue
-		}
-		if replacement, ok := eddystoneUrlReplacements[b]; ok {
-			url = append(url, replacement...)
-		} else {
-			return ErrInvalidURL
-		}
-	}
-	p.Parsed = &EddystoneURLPacket{
-		TxPower0M: txPower,
-		URL:       string(url),
-	}
-	p.DetectedType = EddystoneURL
-	return nil
-}
-
-func (p *Parser) parseEddystoneTLM(section []byte) error {
-	if len(section) < 4 {
-		return io.EOF
-	}
-	var err error
-	switch section[3] {
-	case 0x00:
-		err = p.parseEddystonePlainTLM(section)
-	case 0x01:
-		err = p.parseEddystoneEncryptedTLM(section)
-	}
-	return err
-}
-
-func (p *Parser) parseEddystonePlainTLM(section []byte) error {
-	if len(section) != 16 {
-		return io.EOF
-	}
-	p.Parsed = &EddystonePlainTLMPacket{
-		BatteryVoltage:     binary.BigEndian.Uint16(section[4:6]),
-		Temperature:        float64((int16(section[6])<<8)+int16(section[7])) / 256,
-		AdvertisementCount: binary.BigEndian.Uint32(section[8:12]),
-		TimeSincePowerOn:   float64(binary.BigEndian.Uint32(section[12:16])) / 10,
-	}
-	p.DetectedType = EddystoneTLM
-	return nil
-}
-
-func (p *Parser) parseEddystoneEncryptedTLM(section []byte) error {
-	if len(section) != 20 {
-		return io.EOF
-	}
-	p.Parsed = &EddystoneEncryptedTLMPacket{
-		Telemetry: section[4:16],
-		Salt:      section[16:18],
-		MIC:       section[18:20],
-	}
-	p.DetectedType = EddystoneETLM
-	return nil
-}
-
-func (p *Parser) parseEddystoneEID(section []byte) error {
-	if len(section) != 12 {
-		return io.EOF
-	}
-	p.Parsed = &EddystoneEIDPacket{
-		TxPower0M: int8(section[3]),
-		EID:       section[4:12],
-	}
-	p.DetectedType = EddystoneEID
-	return nil
-}
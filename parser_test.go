@@ -3,6 +3,7 @@ package kontaktparser
 import (
 	"encoding/hex"
 	"io"
+	"sync"
 	"testing"
 
 	"github.com/google/uuid"
@@ -106,6 +107,34 @@ func TestParseKontaktPlainTooShort(t *testing.T) {
 	assert.Equal(t, Unknown, parser.DetectedType)
 }
 
+func TestParseKontaktShuffled(t *testing.T) {
+	bytes, err := hex.DecodeString("19166AFE0106010F640401020304050607080910111213141516")
+	assert.Nil(t, err)
+
+	parser := New(bytes)
+	assert.Nil(t, parser.ParseAdvertisement())
+	assert.Equal(t, KontaktShuffled, parser.DetectedType)
+	if adv, ok := parser.Parsed.(*KontaktShuffledAdvertisement); !ok {
+		t.Errorf("Parsing of kontakt shuffled should result in KontaktShuffledAdvertisement")
+	} else {
+		assert.Equal(t, uint8(6), adv.DeviceModel)
+		assert.Equal(t, uint8(100), adv.BatteryLevel)
+		assert.Equal(t, int8(4), adv.TxPower)
+		assert.Equal(t, []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x10}, adv.EddystoneNamespace)
+		assert.Equal(t, []byte{0x11, 0x12, 0x13, 0x14, 0x15, 0x16}, adv.EddystoneInstanceID)
+	}
+}
+
+func TestParseKontaktShuffledTooShort(t *testing.T) {
+	// 23 byte section lacks the last byte of instance ID
+	bytes, err := hex.DecodeString("18166AFE0106010F6404010203040506070809101112131415")
+	assert.Nil(t, err)
+
+	parser := New(bytes)
+	assert.Nil(t, parser.ParseAdvertisement())
+	assert.Equal(t, Unknown, parser.DetectedType)
+}
+
 func TestParseScanResponse(t *testing.T) {
 	bytes, err := hex.DecodeString("080961626364656667020A040A160DD061626364040264")
 	assert.Nil(t, err)
@@ -161,6 +190,9 @@ func TestParseLocationFrame(t *testing.T) {
 		assert.Equal(t, uint8(10), adv.DeviceModel)
 		assert.Equal(t, uint8(1), adv.Flags)
 		assert.Equal(t, "ABCDEF", adv.UniqueID)
+		assert.Equal(t, Channel37, adv.Channel())
+		assert.Equal(t, Badge, adv.Model())
+		assert.True(t, adv.LocationFlags().ShufflingEnabled())
 	}
 }
 
@@ -323,3 +355,147 @@ func TestEddystoneEID(t *testing.T) {
 		assert.Equal(t, []byte{0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58}, adv.EID)
 	}
 }
+
+func TestEddystoneUnknownFrameType(t *testing.T) {
+	bytes, err := hex.DecodeString("0616AAFE400102")
+	assert.Nil(t, err)
+
+	parser := New(bytes)
+	assert.Nil(t, parser.ParseAdvertisement())
+	assert.Equal(t, EddystoneUnknown, parser.DetectedType)
+
+	if adv, ok := parser.Parsed.(*EddystoneUnknownPacket); !ok {
+		t.Errorf("Parsing of unknown eddystone frame should result in EddystoneUnknownPacket")
+	} else {
+		assert.Equal(t, byte(0x40), adv.FrameType)
+		assert.Equal(t, []byte{0x01, 0x02}, adv.Payload)
+	}
+}
+
+func TestEddystoneTLMUnsupportedVersion(t *testing.T) {
+	bytes, err := hex.DecodeString("0616AAFE200701")
+	assert.Nil(t, err)
+
+	parser := New(bytes)
+	assert.Equal(t, ErrUnsupportedTLMVersion, parser.ParseAdvertisement())
+	assert.Equal(t, EddystoneUnknown, parser.DetectedType)
+
+	if adv, ok := parser.Parsed.(*EddystoneUnknownPacket); !ok {
+		t.Errorf("Parsing of unsupported eddystone tlm should result in EddystoneUnknownPacket")
+	} else {
+		assert.Equal(t, byte(0x20), adv.FrameType)
+		assert.Equal(t, []byte{0x07, 0x01}, adv.Payload)
+	}
+}
+
+func TestParserReset(t *testing.T) {
+	parser := New(decodeFixture(t, "020106"+frameFixtures[IBeacon]))
+	assert.Nil(t, parser.ParseAdvertisement())
+	assert.Equal(t, IBeacon, parser.DetectedType)
+	assert.Equal(t, byte(0x06), parser.Flags)
+
+	parser.Reset(decodeFixture(t, "0303AAFE"))
+	assert.Equal(t, Unknown, parser.DetectedType)
+	assert.Equal(t, byte(0), parser.Flags)
+	assert.Nil(t, parser.Parsed)
+	assert.Nil(t, parser.ParseAdvertisement())
+	assert.Equal(t, Unknown, parser.DetectedType)
+	assert.Nil(t, parser.Parsed)
+
+	parser.Reset(decodeFixture(t, frameFixtures[KontaktPlain]))
+	assert.Nil(t, parser.ParseAdvertisement())
+	assert.Equal(t, KontaktPlain, parser.DetectedType)
+	assert.Equal(t, "abcdef", parser.Parsed.(*KontaktPlainAdvertisement).UniqueID)
+}
+
+func TestParserResetScanResponse(t *testing.T) {
+	parser := New(decodeFixture(t, "020106"+frameFixtures[KontaktPlain]))
+	assert.Nil(t, parser.ParseAdvertisement())
+
+	parser.ResetScanResponse(decodeFixture(t, scanResponseFixture))
+	assert.Equal(t, byte(0), parser.Flags)
+	assert.Nil(t, parser.ParseScanResponse())
+	assert.Equal(t, KontaktScanResponse, parser.DetectedType)
+	assert.Equal(t, "abcd", parser.Parsed.(*KontaktIOScanResponse).UniqueID)
+
+	parser.ResetScanResponse([]byte{})
+	assert.Nil(t, parser.ParseScanResponse())
+	assert.Equal(t, Unknown, parser.DetectedType)
+	assert.Nil(t, parser.Parsed)
+}
+
+func TestParserKeepsFlags(t *testing.T) {
+	parser := New(decodeFixture(t, "020106"+frameFixtures[KontaktPlain]))
+	assert.Nil(t, parser.ParseAdvertisement())
+	assert.Equal(t, byte(0x06), parser.Flags)
+	// data is consumed, parsing it again doesn't change the result
+	assert.Nil(t, parser.ParseAdvertisement())
+	assert.Equal(t, byte(0x06), parser.Flags)
+	assert.Equal(t, KontaktPlain, parser.DetectedType)
+
+	parser = New(decodeFixture(t, frameFixtures[KontaktPlain]))
+	assert.Nil(t, parser.ParseAdvertisement())
+	assert.Equal(t, byte(0), parser.Flags)
+}
+
+func TestParserTruncatedSections(t *testing.T) {
+	// zero length section
+	parser := New(decodeFixture(t, "0001"))
+	assert.Equal(t, io.EOF, parser.ParseAdvertisement())
+	parser = New(decodeFixture(t, "0009"))
+	assert.Equal(t, io.EOF, parser.ParseScanResponse())
+
+	// flags section without flags
+	parser = New(decodeFixture(t, "0101"))
+	assert.Equal(t, io.EOF, parser.ParseAdvertisement())
+	assert.Equal(t, byte(0), parser.Flags)
+
+	// TX power section without TX power
+	parser = New(decodeFixture(t, "010A"))
+	assert.Equal(t, io.EOF, parser.ParseScanResponse())
+	assert.Equal(t, Unknown, parser.DetectedType)
+}
+
+func TestParserZeroValue(t *testing.T) {
+	parser := Parser{}
+	assert.Nil(t, parser.ParseAdvertisement())
+	assert.Equal(t, Unknown, parser.DetectedType)
+	assert.Nil(t, parser.ParseScanResponse())
+	assert.Equal(t, Unknown, parser.DetectedType)
+}
+
+func TestParserPool(t *testing.T) {
+	pool := sync.Pool{New: func() interface{} { return &Parser{} }}
+	wg := sync.WaitGroup{}
+	for typ, dataHex := range frameFixtures {
+		data := decodeFixture(t, dataHex)
+		expected := parseFixture(t, dataHex, false).Parsed
+		wg.Add(1)
+		go func(typ DetectedType, data []byte, expected interface{}) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				parser := pool.Get().(*Parser)
+				parser.Reset(data)
+				assert.Nil(t, parser.ParseAdvertisement())
+				assert.Equal(t, typ, parser.DetectedType)
+				assert.Equal(t, expected, parser.Parsed)
+				parser.Reset(nil)
+				pool.Put(parser)
+			}
+		}(typ, data, expected)
+	}
+	wg.Wait()
+}
+
+func BenchmarkParserReset(b *testing.B) {
+	data := decodeFixture(b, frameFixtures[KontaktPlain])
+	parser := Parser{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser.Reset(data)
+		if err := parser.ParseAdvertisement(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
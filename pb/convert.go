@@ -0,0 +1,374 @@
+//go:generate protoc --go_out=. --go_opt=paths=source_relative frames.proto
+
+// Package pb contains protobuf representation of frames parsed by kontaktparser, with conversion
+// functions between both representations.
+package pb
+
+import (
+	"errors"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnsupportedFrame = errors.New("unsupported frame")
+)
+
+// FromParser converts parsing result, including advertisement flags, to Frame
+func FromParser(parser *kontaktparser.Parser) (*Frame, error) {
+	frame, err := FromParsed(parser.Parsed)
+	if err != nil {
+		return nil, err
+	}
+	frame.Flags = uint32(parser.Flags)
+	return frame, nil
+}
+
+// FromParsed converts parsed frame (Parser.Parsed) to Frame
+func FromParsed(parsed interface{}) (*Frame, error) {
+	frame := &Frame{Type: DetectedType(kontaktparser.FrameType(parsed))}
+	switch p := parsed.(type) {
+	case *kontaktparser.IBeaconAdvertisement:
+		frame.Frame = &Frame_Ibeacon{Ibeacon: fromIBeacon(p)}
+	case *kontaktparser.KontaktIOScanResponse:
+		scanResponse := &KontaktScanResponse{
+			Name:          p.Name,
+			HasName:       p.HasName,
+			TxPower:       int32(p.TxPower),
+			HasTxPower:    p.HasTxPower,
+			Firmware:      p.Firmware,
+			BatteryLevel:  uint32(p.BatteryLevel),
+			UniqueId:      p.UniqueID,
+			HasIdentifier: p.HasIdentifier,
+		}
+		if p.ShuffledIBeacon != (kontaktparser.IBeaconAdvertisement{}) {
+			scanResponse.ShuffledIbeacon = fromIBeacon(&p.ShuffledIBeacon)
+		}
+		frame.Frame = &Frame_KontaktScanResponse{KontaktScanResponse: scanResponse}
+	case *kontaktparser.KontaktPlainAdvertisement:
+		frame.Frame = &Frame_KontaktPlain{KontaktPlain: &KontaktPlain{
+			DeviceModel:   uint32(p.DeviceModel),
+			FirmwareMajor: uint32(p.FirmwareMajor),
+			FirmwareMinor: uint32(p.FirmwareMinor),
+			BatteryLevel:  uint32(p.BatteryLevel),
+			TxPower:       int32(p.TxPower),
+			UniqueId:      p.UniqueID,
+		}}
+	case *kontaktparser.KontaktShuffledAdvertisement:
+		frame.Frame = &Frame_KontaktShuffled{KontaktShuffled: &KontaktShuffled{
+			DeviceModel:         uint32(p.DeviceModel),
+			FirmwareMajor:       uint32(p.FirmwareMajor),
+			FirmwareMinor:       uint32(p.FirmwareMinor),
+			BatteryLevel:        uint32(p.BatteryLevel),
+			TxPower:             int32(p.TxPower),
+			EddystoneNamespace:  p.EddystoneNamespace,
+			EddystoneInstanceId: p.EddystoneInstanceID,
+		}}
+	case *kontaktparser.KontaktTelemetryAdvertisement:
+		fields := make([]*TelemetryValue, len(p.Fields))
+		for i, field := range p.Fields {
+			fields[i] = fromTelemetryValue(field)
+		}
+		frame.Frame = &Frame_KontaktTelemetry{KontaktTelemetry: &KontaktTelemetry{Fields: fields}}
+	case *kontaktparser.KontaktLocationAdvertisement:
+		frame.Frame = &Frame_KontaktLocation{KontaktLocation: &KontaktLocation{
+			TxPower:     int32(p.TxPower),
+			BleChannel:  uint32(p.BleChannel),
+			DeviceModel: uint32(p.DeviceModel),
+			Flags:       uint32(p.Flags),
+			UniqueId:    p.UniqueID,
+		}}
+	case *kontaktparser.EddystoneUIDPacket:
+		frame.Frame = &Frame_EddystoneUid{EddystoneUid: &EddystoneUID{
+			TxPower_0M: int32(p.TxPower0M),
+			Namespace:  p.Namespace,
+			InstanceId: p.InstanceId,
+		}}
+	case *kontaktparser.EddystoneURLPacket:
+		frame.Frame = &Frame_EddystoneUrl{EddystoneUrl: &EddystoneURL{
+			TxPower_0M: int32(p.TxPower0M),
+			Url:        p.URL,
+		}}
+	case *kontaktparser.EddystonePlainTLMPacket:
+		frame.Frame = &Frame_EddystoneTlm{EddystoneTlm: &EddystoneTLM{
+			BatteryVoltage:     uint32(p.BatteryVoltage),
+			Temperature:        p.Temperature,
+			AdvertisementCount: p.AdvertisementCount,
+			TimeSincePowerOn:   p.TimeSincePowerOn,
+		}}
+	case *kontaktparser.EddystoneEncryptedTLMPacket:
+		frame.Frame = &Frame_EddystoneEtlm{EddystoneEtlm: &EddystoneETLM{
+			Telemetry: p.Telemetry,
+			Salt:      p.Salt,
+			Mic:       p.MIC,
+		}}
+	case *kontaktparser.EddystoneEIDPacket:
+		frame.Frame = &Frame_EddystoneEid{EddystoneEid: &EddystoneEID{
+			TxPower_0M: int32(p.TxPower0M),
+			Eid:        p.EID,
+		}}
+	case *kontaktparser.EddystoneUnknownPacket:
+		frame.Frame = &Frame_EddystoneUnknown{EddystoneUnknown: &EddystoneUnknown{
+			FrameType: uint32(p.FrameType),
+			Payload:   p.Payload,
+		}}
+	default:
+		return nil, ErrUnsupportedFrame
+	}
+	return frame, nil
+}
+
+func fromIBeacon(p *kontaktparser.IBeaconAdvertisement) *IBeacon {
+	proximity := p.ProximityUUID
+	return &IBeacon{
+		CalibratedRssi: int32(p.CalibratedRssi),
+		ProximityUuid:  proximity[:],
+		Major:          uint32(p.Major),
+		Minor:          uint32(p.Minor),
+	}
+}
+
+// fromTelemetryValue converts raw field and, if it's valid, its decoded value
+func fromTelemetryValue(field kontaktparser.KontaktTelemetryValue) *TelemetryValue {
+	value := &TelemetryValue{Pid: TelemetryPID(field.PID), Value: field.Value}
+	switch field.PID {
+	case kontaktparser.SystemHealth:
+		p := kontaktparser.SystemHealthFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_SystemHealth{SystemHealth: &SystemHealthTelemetry{
+				UnixTimestamp: p.UnixTimestamp,
+				BatteryLevel:  uint32(p.BatteryLevel),
+			}}
+		}
+	case kontaktparser.Accelerometer:
+		p := kontaktparser.AccelerometerFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_Accelerometer{Accelerometer: &AccelerometerTelemetry{
+				Sensitivity:           uint32(p.Sensitivity),
+				X:                     int32(p.X),
+				Y:                     int32(p.Y),
+				Z:                     int32(p.Z),
+				SecondsSinceDoubleTap: uint32(p.SecondsSinceDoubleTap),
+				SecondsSinceThreshold: uint32(p.SecondsSinceThreshold),
+			}}
+		}
+	case kontaktparser.Sensors:
+		p := kontaktparser.SensorsFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_Sensors{Sensors: &SensorsTelemetry{
+				LightLevel:  uint32(p.LightLevel),
+				Temperature: int32(p.Temperature),
+			}}
+		}
+	case kontaktparser.Acceleration:
+		p := kontaktparser.AccelerationFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_Acceleration{Acceleration: &AccelerationTelemetry{
+				Sensitivity: uint32(p.Sensitivity),
+				X:           int32(p.X),
+				Y:           int32(p.Y),
+				Z:           int32(p.Z),
+			}}
+		}
+	case kontaktparser.Movement:
+		p := kontaktparser.MovementFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_Movement{Movement: &MovementTelemetry{
+				SecondsSinceThreshold: uint32(p.SecondsSinceThreshold),
+			}}
+		}
+	case kontaktparser.DoubleTap:
+		p := kontaktparser.DoubleTapFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_DoubleTap{DoubleTap: &DoubleTapTelemetry{
+				SecondsSinceDoubleTap: uint32(p.SecondsSinceDoubleTap),
+			}}
+		}
+	case kontaktparser.LightLevel:
+		p := kontaktparser.LightLevelFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_LightLevel{LightLevel: &LightLevelTelemetry{
+				LightLevel: uint32(p.LightLevel),
+			}}
+		}
+	case kontaktparser.Temperature8Bit:
+		p := kontaktparser.Temperature8BitFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_Temperature_8Bit{Temperature_8Bit: &Temperature8BitTelemetry{
+				Temperature: int32(p.Temperature),
+			}}
+		}
+	case kontaktparser.Temperature16Bit:
+		p := kontaktparser.Temperature16BitFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_Temperature_16Bit{Temperature_16Bit: &Temperature16BitTelemetry{
+				Temperature: p.Temperature,
+			}}
+		}
+	case kontaktparser.BatteryLevel:
+		p := kontaktparser.BatteryFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_Battery{Battery: &BatteryTelemetry{
+				BatteryLevel: uint32(p.BatteryLevel),
+			}}
+		}
+	case kontaktparser.Click:
+		p := kontaktparser.ClickFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_Click{Click: &ClickTelemetry{
+				SecondsSinceClick: uint32(p.SecondsSinceClick),
+			}}
+		}
+	case kontaktparser.ClickInfo:
+		p := kontaktparser.ClickInfoFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_ClickInfo{ClickInfo: &ClickInfoTelemetry{
+				ClickId:           uint32(p.ClickID),
+				SecondsSinceClick: uint32(p.SecondsSinceClick),
+			}}
+		}
+	case kontaktparser.UTCTime:
+		p := kontaktparser.UTCTimeFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_UtcTime{UtcTime: &UTCTimeTelemetry{
+				UtcTime: p.UTCTime,
+			}}
+		}
+	case kontaktparser.Humidity:
+		p := kontaktparser.HumidityFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_Humidity{Humidity: &HumidityTelemetry{
+				Humidity: uint32(p.Humidity),
+			}}
+		}
+	case kontaktparser.MovementInfo:
+		p := kontaktparser.MovementInfoFieldParser{}
+		if p.Parse(field) == nil {
+			value.Decoded = &TelemetryValue_MovementInfo{MovementInfo: &MovementInfoTelemetry{
+				Counter:               uint32(p.Counter),
+				SecondsSinceThreshold: uint32(p.SecondsSinceThreshold),
+			}}
+		}
+	}
+	return value
+}
+
+// ToParsed converts Frame to the type Parser.Parsed would hold
+func ToParsed(frame *Frame) (interface{}, error) {
+	switch f := frame.GetFrame().(type) {
+	case *Frame_Ibeacon:
+		return toIBeacon(f.Ibeacon)
+	case *Frame_KontaktScanResponse:
+		p := f.KontaktScanResponse
+		scanResponse := &kontaktparser.KontaktIOScanResponse{
+			Name:          p.Name,
+			HasName:       p.HasName,
+			TxPower:       int8(p.TxPower),
+			HasTxPower:    p.HasTxPower,
+			Firmware:      p.Firmware,
+			BatteryLevel:  uint8(p.BatteryLevel),
+			UniqueID:      p.UniqueId,
+			HasIdentifier: p.HasIdentifier,
+		}
+		if p.ShuffledIbeacon != nil {
+			ibeacon, err := toIBeacon(p.ShuffledIbeacon)
+			if err != nil {
+				return nil, err
+			}
+			scanResponse.ShuffledIBeacon = *ibeacon
+		}
+		return scanResponse, nil
+	case *Frame_KontaktPlain:
+		p := f.KontaktPlain
+		return &kontaktparser.KontaktPlainAdvertisement{
+			DeviceModel:   uint8(p.DeviceModel),
+			FirmwareMajor: uint8(p.FirmwareMajor),
+			FirmwareMinor: uint8(p.FirmwareMinor),
+			BatteryLevel:  uint8(p.BatteryLevel),
+			TxPower:       int8(p.TxPower),
+			UniqueID:      p.UniqueId,
+		}, nil
+	case *Frame_KontaktShuffled:
+		p := f.KontaktShuffled
+		return &kontaktparser.KontaktShuffledAdvertisement{
+			DeviceModel:         uint8(p.DeviceModel),
+			FirmwareMajor:       uint8(p.FirmwareMajor),
+			FirmwareMinor:       uint8(p.FirmwareMinor),
+			BatteryLevel:        uint8(p.BatteryLevel),
+			TxPower:             int8(p.TxPower),
+			EddystoneNamespace:  p.EddystoneNamespace,
+			EddystoneInstanceID: p.EddystoneInstanceId,
+		}, nil
+	case *Frame_KontaktTelemetry:
+		fields := make([]kontaktparser.KontaktTelemetryValue, len(f.KontaktTelemetry.Fields))
+		for i, field := range f.KontaktTelemetry.Fields {
+			fields[i] = kontaktparser.KontaktTelemetryValue{
+				PID:   kontaktparser.TelemetryPID(field.Pid),
+				Value: field.Value,
+			}
+		}
+		return &kontaktparser.KontaktTelemetryAdvertisement{Fields: fields}, nil
+	case *Frame_KontaktLocation:
+		p := f.KontaktLocation
+		return &kontaktparser.KontaktLocationAdvertisement{
+			TxPower:     int8(p.TxPower),
+			BleChannel:  uint8(p.BleChannel),
+			DeviceModel: uint8(p.DeviceModel),
+			Flags:       uint8(p.Flags),
+			UniqueID:    p.UniqueId,
+		}, nil
+	case *Frame_EddystoneUid:
+		p := f.EddystoneUid
+		return &kontaktparser.EddystoneUIDPacket{
+			TxPower0M:  int8(p.TxPower_0M),
+			Namespace:  p.Namespace,
+			InstanceId: p.InstanceId,
+		}, nil
+	case *Frame_EddystoneUrl:
+		return &kontaktparser.EddystoneURLPacket{
+			TxPower0M: int8(f.EddystoneUrl.TxPower_0M),
+			URL:       f.EddystoneUrl.Url,
+		}, nil
+	case *Frame_EddystoneTlm:
+		p := f.EddystoneTlm
+		return &kontaktparser.EddystonePlainTLMPacket{
+			BatteryVoltage:     uint16(p.BatteryVoltage),
+			Temperature:        p.Temperature,
+			AdvertisementCount: p.AdvertisementCount,
+			TimeSincePowerOn:   p.TimeSincePowerOn,
+		}, nil
+	case *Frame_EddystoneEtlm:
+		p := f.EddystoneEtlm
+		return &kontaktparser.EddystoneEncryptedTLMPacket{
+			Telemetry: p.Telemetry,
+			Salt:      p.Salt,
+			MIC:       p.Mic,
+		}, nil
+	case *Frame_EddystoneEid:
+		return &kontaktparser.EddystoneEIDPacket{
+			TxPower0M: int8(f.EddystoneEid.TxPower_0M),
+			EID:       f.EddystoneEid.Eid,
+		}, nil
+	case *Frame_EddystoneUnknown:
+		return &kontaktparser.EddystoneUnknownPacket{
+			FrameType: byte(f.EddystoneUnknown.FrameType),
+			Payload:   f.EddystoneUnknown.Payload,
+		}, nil
+	}
+	return nil, ErrUnsupportedFrame
+}
+
+func toIBeacon(p *IBeacon) (*kontaktparser.IBeaconAdvertisement, error) {
+	proximity, err := uuid.FromBytes(p.ProximityUuid)
+	if err != nil {
+		return nil, err
+	}
+	return &kontaktparser.IBeaconAdvertisement{
+		CalibratedRssi: int8(p.CalibratedRssi),
+		ProximityUUID:  proximity,
+		Major:          uint16(p.Major),
+		Minor:          uint16(p.Minor),
+	}, nil
+}
@@ -0,0 +1,112 @@
+package pb
+
+import (
+	"encoding/hex"
+	"testing"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+var advertisements = []string{
+	"1AFF4C000215F7826DA64FA24E988024BC5B71E0893E01020304B3",
+	"0201060F166AFE0206010F6404616263646566",
+	"19166AFE0106010F640401020304050607080910111213141516",
+	"0C166AFE03020A640411065BA0",
+	"0E166AFE05F4250A01414243444546",
+	"1716AAFE0004010203040506070809000102030405060000",
+	"0B16AAFE100403746573740C",
+	"1116AAFE2000018005400000010000010000",
+	"1516AAFE20010102030405060708090A0B0C01021112",
+	"0D16AAFE30045152535455565758",
+	"0616AAFE400102",
+}
+
+func parse(t *testing.T, dataHex string, scanResponse bool) kontaktparser.Parser {
+	data, err := hex.DecodeString(dataHex)
+	assert.Nil(t, err)
+	parser := kontaktparser.New(data)
+	if scanResponse {
+		assert.Nil(t, parser.ParseScanResponse())
+	} else {
+		assert.Nil(t, parser.ParseAdvertisement())
+	}
+	return parser
+}
+
+func roundTrip(t *testing.T, parser kontaktparser.Parser) {
+	frame, err := FromParser(&parser)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(parser.Flags), frame.Flags)
+	assert.Equal(t, DetectedType(parser.DetectedType), frame.Type)
+
+	data, err := proto.Marshal(frame)
+	assert.Nil(t, err)
+	decoded := &Frame{}
+	assert.Nil(t, proto.Unmarshal(data, decoded))
+
+	parsed, err := ToParsed(decoded)
+	assert.Nil(t, err)
+	assert.Equal(t, parser.Parsed, parsed)
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, dataHex := range advertisements {
+		roundTrip(t, parse(t, dataHex, false))
+	}
+	roundTrip(t, parse(t, "080961626364656667020A040A160DD061626364040264", true))
+}
+
+func TestScanResponseWithShuffledIBeacon(t *testing.T) {
+	parser := parse(t, "080961626364656667020A040A160DD061626364040264", true)
+	ibeacon := parse(t, advertisements[0], false)
+	parser.Parsed.(*kontaktparser.KontaktIOScanResponse).ShuffledIBeacon = *ibeacon.Parsed.(*kontaktparser.IBeaconAdvertisement)
+	roundTrip(t, parser)
+}
+
+func TestDecodedTelemetry(t *testing.T) {
+	parser := parse(t, advertisements[3], false)
+	frame, err := FromParsed(parser.Parsed)
+	assert.Nil(t, err)
+
+	fields := frame.GetKontaktTelemetry().GetFields()
+	assert.Equal(t, 2, len(fields))
+	assert.Equal(t, TelemetryPID_TELEMETRY_PID_LIGHT_LEVEL, fields[0].Pid)
+	assert.Equal(t, uint32(100), fields[0].GetLightLevel().GetLightLevel())
+	assert.Equal(t, TelemetryPID_TELEMETRY_PID_CLICK_INFO, fields[1].Pid)
+	assert.Equal(t, uint32(6), fields[1].GetClickInfo().GetClickId())
+	assert.Equal(t, uint32(41051), fields[1].GetClickInfo().GetSecondsSinceClick())
+}
+
+func TestEveryTelemetryFieldIsDecoded(t *testing.T) {
+	for pid := 0; pid < 256; pid++ {
+		parser, ok := kontaktparser.NewFieldParser(kontaktparser.TelemetryPID(pid))
+		if !ok {
+			continue
+		}
+		for length := 0; length <= 16; length++ {
+			field := kontaktparser.KontaktTelemetryValue{PID: kontaktparser.TelemetryPID(pid), Value: make([]byte, length)}
+			if parser.Parse(field) == nil {
+				assert.NotNil(t, fromTelemetryValue(field).GetDecoded(), field.PID.String())
+				break
+			}
+		}
+	}
+}
+
+func TestInvalidTelemetryIsNotDecoded(t *testing.T) {
+	frame, err := FromParsed(&kontaktparser.KontaktTelemetryAdvertisement{Fields: []kontaktparser.KontaktTelemetryValue{
+		{PID: kontaktparser.Humidity, Value: []byte{0x01, 0x02}},
+	}})
+	assert.Nil(t, err)
+	assert.Nil(t, frame.GetKontaktTelemetry().GetFields()[0].GetDecoded())
+}
+
+func TestUnsupportedFrame(t *testing.T) {
+	_, err := FromParsed(nil)
+	assert.Equal(t, ErrUnsupportedFrame, err)
+	_, err = ToParsed(&Frame{})
+	assert.Equal(t, ErrUnsupportedFrame, err)
+}
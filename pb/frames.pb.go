@@ -0,0 +1,3260 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: frames.proto
+
+// Parsed Kontakt.io, iBeacon and Eddystone frames. Field types are widened to the nearest protobuf
+// scalar type: int8 to sint32, uint8 and uint16 to uint32.
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DetectedType mirrors kontaktparser.DetectedType, values are equal.
+type DetectedType int32
+
+const (
+	DetectedType_DETECTED_TYPE_UNKNOWN               DetectedType = 0
+	DetectedType_DETECTED_TYPE_IBEACON               DetectedType = 1
+	DetectedType_DETECTED_TYPE_EDDYSTONE_UID         DetectedType = 2
+	DetectedType_DETECTED_TYPE_EDDYSTONE_URL         DetectedType = 3
+	DetectedType_DETECTED_TYPE_EDDYSTONE_TLM         DetectedType = 4
+	DetectedType_DETECTED_TYPE_EDDYSTONE_EID         DetectedType = 5
+	DetectedType_DETECTED_TYPE_EDDYSTONE_ETLM        DetectedType = 6
+	DetectedType_DETECTED_TYPE_KONTAKT_SCAN_RESPONSE DetectedType = 7
+	DetectedType_DETECTED_TYPE_KONTAKT_PLAIN         DetectedType = 8
+	DetectedType_DETECTED_TYPE_KONTAKT_SHUFFLED      DetectedType = 9
+	DetectedType_DETECTED_TYPE_KONTAKT_TELEMETRY     DetectedType = 10
+	DetectedType_DETECTED_TYPE_KONTAKT_LOCATION      DetectedType = 11
+	DetectedType_DETECTED_TYPE_EDDYSTONE_UNKNOWN     DetectedType = 12
+)
+
+// Enum value maps for DetectedType.
+var (
+	DetectedType_name = map[int32]string{
+		0:  "DETECTED_TYPE_UNKNOWN",
+		1:  "DETECTED_TYPE_IBEACON",
+		2:  "DETECTED_TYPE_EDDYSTONE_UID",
+		3:  "DETECTED_TYPE_EDDYSTONE_URL",
+		4:  "DETECTED_TYPE_EDDYSTONE_TLM",
+		5:  "DETECTED_TYPE_EDDYSTONE_EID",
+		6:  "DETECTED_TYPE_EDDYSTONE_ETLM",
+		7:  "DETECTED_TYPE_KONTAKT_SCAN_RESPONSE",
+		8:  "DETECTED_TYPE_KONTAKT_PLAIN",
+		9:  "DETECTED_TYPE_KONTAKT_SHUFFLED",
+		10: "DETECTED_TYPE_KONTAKT_TELEMETRY",
+		11: "DETECTED_TYPE_KONTAKT_LOCATION",
+		12: "DETECTED_TYPE_EDDYSTONE_UNKNOWN",
+	}
+	DetectedType_value = map[string]int32{
+		"DETECTED_TYPE_UNKNOWN":               0,
+		"DETECTED_TYPE_IBEACON":               1,
+		"DETECTED_TYPE_EDDYSTONE_UID":         2,
+		"DETECTED_TYPE_EDDYSTONE_URL":         3,
+		"DETECTED_TYPE_EDDYSTONE_TLM":         4,
+		"DETECTED_TYPE_EDDYSTONE_EID":         5,
+		"DETECTED_TYPE_EDDYSTONE_ETLM":        6,
+		"DETECTED_TYPE_KONTAKT_SCAN_RESPONSE": 7,
+		"DETECTED_TYPE_KONTAKT_PLAIN":         8,
+		"DETECTED_TYPE_KONTAKT_SHUFFLED":      9,
+		"DETECTED_TYPE_KONTAKT_TELEMETRY":     10,
+		"DETECTED_TYPE_KONTAKT_LOCATION":      11,
+		"DETECTED_TYPE_EDDYSTONE_UNKNOWN":     12,
+	}
+)
+
+func (x DetectedType) Enum() *DetectedType {
+	p := new(DetectedType)
+	*p = x
+	return p
+}
+
+func (x DetectedType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DetectedType) Descriptor() protoreflect.EnumDescriptor {
+	return file_frames_proto_enumTypes[0].Descriptor()
+}
+
+func (DetectedType) Type() protoreflect.EnumType {
+	return &file_frames_proto_enumTypes[0]
+}
+
+func (x DetectedType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DetectedType.Descriptor instead.
+func (DetectedType) EnumDescriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{0}
+}
+
+// TelemetryPID mirrors kontaktparser.TelemetryPID, values are equal.
+type TelemetryPID int32
+
+const (
+	TelemetryPID_TELEMETRY_PID_UNSPECIFIED       TelemetryPID = 0
+	TelemetryPID_TELEMETRY_PID_SYSTEM_HEALTH     TelemetryPID = 1
+	TelemetryPID_TELEMETRY_PID_ACCELEROMETER     TelemetryPID = 2
+	TelemetryPID_TELEMETRY_PID_SENSORS           TelemetryPID = 5
+	TelemetryPID_TELEMETRY_PID_ACCELERATION      TelemetryPID = 6
+	TelemetryPID_TELEMETRY_PID_MOVEMENT          TelemetryPID = 7
+	TelemetryPID_TELEMETRY_PID_DOUBLE_TAP        TelemetryPID = 8
+	TelemetryPID_TELEMETRY_PID_LIGHT_LEVEL       TelemetryPID = 10
+	TelemetryPID_TELEMETRY_PID_TEMPERATURE_8BIT  TelemetryPID = 11
+	TelemetryPID_TELEMETRY_PID_BATTERY_LEVEL     TelemetryPID = 12
+	TelemetryPID_TELEMETRY_PID_CLICK             TelemetryPID = 13
+	TelemetryPID_TELEMETRY_PID_UTC_TIME          TelemetryPID = 15
+	TelemetryPID_TELEMETRY_PID_CLICK_INFO        TelemetryPID = 17
+	TelemetryPID_TELEMETRY_PID_HUMIDITY          TelemetryPID = 18
+	TelemetryPID_TELEMETRY_PID_TEMPERATURE_16BIT TelemetryPID = 19
+	TelemetryPID_TELEMETRY_PID_MOVEMENT_INFO     TelemetryPID = 22
+)
+
+// Enum value maps for TelemetryPID.
+var (
+	TelemetryPID_name = map[int32]string{
+		0:  "TELEMETRY_PID_UNSPECIFIED",
+		1:  "TELEMETRY_PID_SYSTEM_HEALTH",
+		2:  "TELEMETRY_PID_ACCELEROMETER",
+		5:  "TELEMETRY_PID_SENSORS",
+		6:  "TELEMETRY_PID_ACCELERATION",
+		7:  "TELEMETRY_PID_MOVEMENT",
+		8:  "TELEMETRY_PID_DOUBLE_TAP",
+		10: "TELEMETRY_PID_LIGHT_LEVEL",
+		11: "TELEMETRY_PID_TEMPERATURE_8BIT",
+		12: "TELEMETRY_PID_BATTERY_LEVEL",
+		13: "TELEMETRY_PID_CLICK",
+		15: "TELEMETRY_PID_UTC_TIME",
+		17: "TELEMETRY_PID_CLICK_INFO",
+		18: "TELEMETRY_PID_HUMIDITY",
+		19: "TELEMETRY_PID_TEMPERATURE_16BIT",
+		22: "TELEMETRY_PID_MOVEMENT_INFO",
+	}
+	TelemetryPID_value = map[string]int32{
+		"TELEMETRY_PID_UNSPECIFIED":       0,
+		"TELEMETRY_PID_SYSTEM_HEALTH":     1,
+		"TELEMETRY_PID_ACCELEROMETER":     2,
+		"TELEMETRY_PID_SENSORS":           5,
+		"TELEMETRY_PID_ACCELERATION":      6,
+		"TELEMETRY_PID_MOVEMENT":          7,
+		"TELEMETRY_PID_DOUBLE_TAP":        8,
+		"TELEMETRY_PID_LIGHT_LEVEL":       10,
+		"TELEMETRY_PID_TEMPERATURE_8BIT":  11,
+		"TELEMETRY_PID_BATTERY_LEVEL":     12,
+		"TELEMETRY_PID_CLICK":             13,
+		"TELEMETRY_PID_UTC_TIME":          15,
+		"TELEMETRY_PID_CLICK_INFO":        17,
+		"TELEMETRY_PID_HUMIDITY":          18,
+		"TELEMETRY_PID_TEMPERATURE_16BIT": 19,
+		"TELEMETRY_PID_MOVEMENT_INFO":     22,
+	}
+)
+
+func (x TelemetryPID) Enum() *TelemetryPID {
+	p := new(TelemetryPID)
+	*p = x
+	return p
+}
+
+func (x TelemetryPID) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TelemetryPID) Descriptor() protoreflect.EnumDescriptor {
+	return file_frames_proto_enumTypes[1].Descriptor()
+}
+
+func (TelemetryPID) Type() protoreflect.EnumType {
+	return &file_frames_proto_enumTypes[1]
+}
+
+func (x TelemetryPID) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TelemetryPID.Descriptor instead.
+func (TelemetryPID) EnumDescriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{1}
+}
+
+// Frame is a single parsed advertisement or scan response.
+type Frame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type DetectedType `protobuf:"varint,1,opt,name=type,proto3,enum=kontaktparser.v1.DetectedType" json:"type,omitempty"`
+	// flags is a value of advertisement flags section.
+	Flags uint32 `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	// Types that are assignable to Frame:
+	//	*Frame_Ibeacon
+	//	*Frame_KontaktScanResponse
+	//	*Frame_KontaktPlain
+	//	*Frame_KontaktShuffled
+	//	*Frame_KontaktTelemetry
+	//	*Frame_KontaktLocation
+	//	*Frame_EddystoneUid
+	//	*Frame_EddystoneUrl
+	//	*Frame_EddystoneTlm
+	//	*Frame_EddystoneEtlm
+	//	*Frame_EddystoneEid
+	//	*Frame_EddystoneUnknown
+	Frame isFrame_Frame `protobuf_oneof:"frame"`
+}
+
+func (x *Frame) Reset() {
+	*x = Frame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Frame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Frame) ProtoMessage() {}
+
+func (x *Frame) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Frame.ProtoReflect.Descriptor instead.
+func (*Frame) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Frame) GetType() DetectedType {
+	if x != nil {
+		return x.Type
+	}
+	return DetectedType_DETECTED_TYPE_UNKNOWN
+}
+
+func (x *Frame) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (m *Frame) GetFrame() isFrame_Frame {
+	if m != nil {
+		return m.Frame
+	}
+	return nil
+}
+
+func (x *Frame) GetIbeacon() *IBeacon {
+	if x, ok := x.GetFrame().(*Frame_Ibeacon); ok {
+		return x.Ibeacon
+	}
+	return nil
+}
+
+func (x *Frame) GetKontaktScanResponse() *KontaktScanResponse {
+	if x, ok := x.GetFrame().(*Frame_KontaktScanResponse); ok {
+		return x.KontaktScanResponse
+	}
+	return nil
+}
+
+func (x *Frame) GetKontaktPlain() *KontaktPlain {
+	if x, ok := x.GetFrame().(*Frame_KontaktPlain); ok {
+		return x.KontaktPlain
+	}
+	return nil
+}
+
+func (x *Frame) GetKontaktShuffled() *KontaktShuffled {
+	if x, ok := x.GetFrame().(*Frame_KontaktShuffled); ok {
+		return x.KontaktShuffled
+	}
+	return nil
+}
+
+func (x *Frame) GetKontaktTelemetry() *KontaktTelemetry {
+	if x, ok := x.GetFrame().(*Frame_KontaktTelemetry); ok {
+		return x.KontaktTelemetry
+	}
+	return nil
+}
+
+func (x *Frame) GetKontaktLocation() *KontaktLocation {
+	if x, ok := x.GetFrame().(*Frame_KontaktLocation); ok {
+		return x.KontaktLocation
+	}
+	return nil
+}
+
+func (x *Frame) GetEddystoneUid() *EddystoneUID {
+	if x, ok := x.GetFrame().(*Frame_EddystoneUid); ok {
+		return x.EddystoneUid
+	}
+	return nil
+}
+
+func (x *Frame) GetEddystoneUrl() *EddystoneURL {
+	if x, ok := x.GetFrame().(*Frame_EddystoneUrl); ok {
+		return x.EddystoneUrl
+	}
+	return nil
+}
+
+func (x *Frame) GetEddystoneTlm() *EddystoneTLM {
+	if x, ok := x.GetFrame().(*Frame_EddystoneTlm); ok {
+		return x.EddystoneTlm
+	}
+	return nil
+}
+
+func (x *Frame) GetEddystoneEtlm() *EddystoneETLM {
+	if x, ok := x.GetFrame().(*Frame_EddystoneEtlm); ok {
+		return x.EddystoneEtlm
+	}
+	return nil
+}
+
+func (x *Frame) GetEddystoneEid() *EddystoneEID {
+	if x, ok := x.GetFrame().(*Frame_EddystoneEid); ok {
+		return x.EddystoneEid
+	}
+	return nil
+}
+
+func (x *Frame) GetEddystoneUnknown() *EddystoneUnknown {
+	if x, ok := x.GetFrame().(*Frame_EddystoneUnknown); ok {
+		return x.EddystoneUnknown
+	}
+	return nil
+}
+
+type isFrame_Frame interface {
+	isFrame_Frame()
+}
+
+type Frame_Ibeacon struct {
+	Ibeacon *IBeacon `protobuf:"bytes,3,opt,name=ibeacon,proto3,oneof"`
+}
+
+type Frame_KontaktScanResponse struct {
+	KontaktScanResponse *KontaktScanResponse `protobuf:"bytes,4,opt,name=kontakt_scan_response,json=kontaktScanResponse,proto3,oneof"`
+}
+
+type Frame_KontaktPlain struct {
+	KontaktPlain *KontaktPlain `protobuf:"bytes,5,opt,name=kontakt_plain,json=kontaktPlain,proto3,oneof"`
+}
+
+type Frame_KontaktShuffled struct {
+	KontaktShuffled *KontaktShuffled `protobuf:"bytes,6,opt,name=kontakt_shuffled,json=kontaktShuffled,proto3,oneof"`
+}
+
+type Frame_KontaktTelemetry struct {
+	KontaktTelemetry *KontaktTelemetry `protobuf:"bytes,7,opt,name=kontakt_telemetry,json=kontaktTelemetry,proto3,oneof"`
+}
+
+type Frame_KontaktLocation struct {
+	KontaktLocation *KontaktLocation `protobuf:"bytes,8,opt,name=kontakt_location,json=kontaktLocation,proto3,oneof"`
+}
+
+type Frame_EddystoneUid struct {
+	EddystoneUid *EddystoneUID `protobuf:"bytes,9,opt,name=eddystone_uid,json=eddystoneUid,proto3,oneof"`
+}
+
+type Frame_EddystoneUrl struct {
+	EddystoneUrl *EddystoneURL `protobuf:"bytes,10,opt,name=eddystone_url,json=eddystoneUrl,proto3,oneof"`
+}
+
+type Frame_EddystoneTlm struct {
+	EddystoneTlm *EddystoneTLM `protobuf:"bytes,11,opt,name=eddystone_tlm,json=eddystoneTlm,proto3,oneof"`
+}
+
+type Frame_EddystoneEtlm struct {
+	EddystoneEtlm *EddystoneETLM `protobuf:"bytes,12,opt,name=eddystone_etlm,json=eddystoneEtlm,proto3,oneof"`
+}
+
+type Frame_EddystoneEid struct {
+	EddystoneEid *EddystoneEID `protobuf:"bytes,13,opt,name=eddystone_eid,json=eddystoneEid,proto3,oneof"`
+}
+
+type Frame_EddystoneUnknown struct {
+	EddystoneUnknown *EddystoneUnknown `protobuf:"bytes,14,opt,name=eddystone_unknown,json=eddystoneUnknown,proto3,oneof"`
+}
+
+func (*Frame_Ibeacon) isFrame_Frame() {}
+
+func (*Frame_KontaktScanResponse) isFrame_Frame() {}
+
+func (*Frame_KontaktPlain) isFrame_Frame() {}
+
+func (*Frame_KontaktShuffled) isFrame_Frame() {}
+
+func (*Frame_KontaktTelemetry) isFrame_Frame() {}
+
+func (*Frame_KontaktLocation) isFrame_Frame() {}
+
+func (*Frame_EddystoneUid) isFrame_Frame() {}
+
+func (*Frame_EddystoneUrl) isFrame_Frame() {}
+
+func (*Frame_EddystoneTlm) isFrame_Frame() {}
+
+func (*Frame_EddystoneEtlm) isFrame_Frame() {}
+
+func (*Frame_EddystoneEid) isFrame_Frame() {}
+
+func (*Frame_EddystoneUnknown) isFrame_Frame() {}
+
+type IBeacon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CalibratedRssi int32 `protobuf:"zigzag32,1,opt,name=calibrated_rssi,json=calibratedRssi,proto3" json:"calibrated_rssi,omitempty"`
+	// proximity_uuid is 16 bytes long.
+	ProximityUuid []byte `protobuf:"bytes,2,opt,name=proximity_uuid,json=proximityUuid,proto3" json:"proximity_uuid,omitempty"`
+	Major         uint32 `protobuf:"varint,3,opt,name=major,proto3" json:"major,omitempty"`
+	Minor         uint32 `protobuf:"varint,4,opt,name=minor,proto3" json:"minor,omitempty"`
+}
+
+func (x *IBeacon) Reset() {
+	*x = IBeacon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IBeacon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IBeacon) ProtoMessage() {}
+
+func (x *IBeacon) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IBeacon.ProtoReflect.Descriptor instead.
+func (*IBeacon) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IBeacon) GetCalibratedRssi() int32 {
+	if x != nil {
+		return x.CalibratedRssi
+	}
+	return 0
+}
+
+func (x *IBeacon) GetProximityUuid() []byte {
+	if x != nil {
+		return x.ProximityUuid
+	}
+	return nil
+}
+
+func (x *IBeacon) GetMajor() uint32 {
+	if x != nil {
+		return x.Major
+	}
+	return 0
+}
+
+func (x *IBeacon) GetMinor() uint32 {
+	if x != nil {
+		return x.Minor
+	}
+	return 0
+}
+
+type KontaktScanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	HasName         bool     `protobuf:"varint,2,opt,name=has_name,json=hasName,proto3" json:"has_name,omitempty"`
+	TxPower         int32    `protobuf:"zigzag32,3,opt,name=tx_power,json=txPower,proto3" json:"tx_power,omitempty"`
+	HasTxPower      bool     `protobuf:"varint,4,opt,name=has_tx_power,json=hasTxPower,proto3" json:"has_tx_power,omitempty"`
+	Firmware        string   `protobuf:"bytes,5,opt,name=firmware,proto3" json:"firmware,omitempty"`
+	BatteryLevel    uint32   `protobuf:"varint,6,opt,name=battery_level,json=batteryLevel,proto3" json:"battery_level,omitempty"`
+	UniqueId        string   `protobuf:"bytes,7,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	HasIdentifier   bool     `protobuf:"varint,8,opt,name=has_identifier,json=hasIdentifier,proto3" json:"has_identifier,omitempty"`
+	ShuffledIbeacon *IBeacon `protobuf:"bytes,9,opt,name=shuffled_ibeacon,json=shuffledIbeacon,proto3" json:"shuffled_ibeacon,omitempty"`
+}
+
+func (x *KontaktScanResponse) Reset() {
+	*x = KontaktScanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KontaktScanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KontaktScanResponse) ProtoMessage() {}
+
+func (x *KontaktScanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KontaktScanResponse.ProtoReflect.Descriptor instead.
+func (*KontaktScanResponse) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KontaktScanResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KontaktScanResponse) GetHasName() bool {
+	if x != nil {
+		return x.HasName
+	}
+	return false
+}
+
+func (x *KontaktScanResponse) GetTxPower() int32 {
+	if x != nil {
+		return x.TxPower
+	}
+	return 0
+}
+
+func (x *KontaktScanResponse) GetHasTxPower() bool {
+	if x != nil {
+		return x.HasTxPower
+	}
+	return false
+}
+
+func (x *KontaktScanResponse) GetFirmware() string {
+	if x != nil {
+		return x.Firmware
+	}
+	return ""
+}
+
+func (x *KontaktScanResponse) GetBatteryLevel() uint32 {
+	if x != nil {
+		return x.BatteryLevel
+	}
+	return 0
+}
+
+func (x *KontaktScanResponse) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *KontaktScanResponse) GetHasIdentifier() bool {
+	if x != nil {
+		return x.HasIdentifier
+	}
+	return false
+}
+
+func (x *KontaktScanResponse) GetShuffledIbeacon() *IBeacon {
+	if x != nil {
+		return x.ShuffledIbeacon
+	}
+	return nil
+}
+
+type KontaktPlain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceModel   uint32 `protobuf:"varint,1,opt,name=device_model,json=deviceModel,proto3" json:"device_model,omitempty"`
+	FirmwareMajor uint32 `protobuf:"varint,2,opt,name=firmware_major,json=firmwareMajor,proto3" json:"firmware_major,omitempty"`
+	FirmwareMinor uint32 `protobuf:"varint,3,opt,name=firmware_minor,json=firmwareMinor,proto3" json:"firmware_minor,omitempty"`
+	BatteryLevel  uint32 `protobuf:"varint,4,opt,name=battery_level,json=batteryLevel,proto3" json:"battery_level,omitempty"`
+	TxPower       int32  `protobuf:"zigzag32,5,opt,name=tx_power,json=txPower,proto3" json:"tx_power,omitempty"`
+	UniqueId      string `protobuf:"bytes,6,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+}
+
+func (x *KontaktPlain) Reset() {
+	*x = KontaktPlain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KontaktPlain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KontaktPlain) ProtoMessage() {}
+
+func (x *KontaktPlain) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KontaktPlain.ProtoReflect.Descriptor instead.
+func (*KontaktPlain) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KontaktPlain) GetDeviceModel() uint32 {
+	if x != nil {
+		return x.DeviceModel
+	}
+	return 0
+}
+
+func (x *KontaktPlain) GetFirmwareMajor() uint32 {
+	if x != nil {
+		return x.FirmwareMajor
+	}
+	return 0
+}
+
+func (x *KontaktPlain) GetFirmwareMinor() uint32 {
+	if x != nil {
+		return x.FirmwareMinor
+	}
+	return 0
+}
+
+func (x *KontaktPlain) GetBatteryLevel() uint32 {
+	if x != nil {
+		return x.BatteryLevel
+	}
+	return 0
+}
+
+func (x *KontaktPlain) GetTxPower() int32 {
+	if x != nil {
+		return x.TxPower
+	}
+	return 0
+}
+
+func (x *KontaktPlain) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+type KontaktShuffled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceModel         uint32 `protobuf:"varint,1,opt,name=device_model,json=deviceModel,proto3" json:"device_model,omitempty"`
+	FirmwareMajor       uint32 `protobuf:"varint,2,opt,name=firmware_major,json=firmwareMajor,proto3" json:"firmware_major,omitempty"`
+	FirmwareMinor       uint32 `protobuf:"varint,3,opt,name=firmware_minor,json=firmwareMinor,proto3" json:"firmware_minor,omitempty"`
+	BatteryLevel        uint32 `protobuf:"varint,4,opt,name=battery_level,json=batteryLevel,proto3" json:"battery_level,omitempty"`
+	TxPower             int32  `protobuf:"zigzag32,5,opt,name=tx_power,json=txPower,proto3" json:"tx_power,omitempty"`
+	EddystoneNamespace  []byte `protobuf:"bytes,6,opt,name=eddystone_namespace,json=eddystoneNamespace,proto3" json:"eddystone_namespace,omitempty"`
+	EddystoneInstanceId []byte `protobuf:"bytes,7,opt,name=eddystone_instance_id,json=eddystoneInstanceId,proto3" json:"eddystone_instance_id,omitempty"`
+}
+
+func (x *KontaktShuffled) Reset() {
+	*x = KontaktShuffled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KontaktShuffled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KontaktShuffled) ProtoMessage() {}
+
+func (x *KontaktShuffled) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KontaktShuffled.ProtoReflect.Descriptor instead.
+func (*KontaktShuffled) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KontaktShuffled) GetDeviceModel() uint32 {
+	if x != nil {
+		return x.DeviceModel
+	}
+	return 0
+}
+
+func (x *KontaktShuffled) GetFirmwareMajor() uint32 {
+	if x != nil {
+		return x.FirmwareMajor
+	}
+	return 0
+}
+
+func (x *KontaktShuffled) GetFirmwareMinor() uint32 {
+	if x != nil {
+		return x.FirmwareMinor
+	}
+	return 0
+}
+
+func (x *KontaktShuffled) GetBatteryLevel() uint32 {
+	if x != nil {
+		return x.BatteryLevel
+	}
+	return 0
+}
+
+func (x *KontaktShuffled) GetTxPower() int32 {
+	if x != nil {
+		return x.TxPower
+	}
+	return 0
+}
+
+func (x *KontaktShuffled) GetEddystoneNamespace() []byte {
+	if x != nil {
+		return x.EddystoneNamespace
+	}
+	return nil
+}
+
+func (x *KontaktShuffled) GetEddystoneInstanceId() []byte {
+	if x != nil {
+		return x.EddystoneInstanceId
+	}
+	return nil
+}
+
+type KontaktLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxPower     int32  `protobuf:"zigzag32,1,opt,name=tx_power,json=txPower,proto3" json:"tx_power,omitempty"`
+	BleChannel  uint32 `protobuf:"varint,2,opt,name=ble_channel,json=bleChannel,proto3" json:"ble_channel,omitempty"`
+	DeviceModel uint32 `protobuf:"varint,3,opt,name=device_model,json=deviceModel,proto3" json:"device_model,omitempty"`
+	Flags       uint32 `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	UniqueId    string `protobuf:"bytes,5,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+}
+
+func (x *KontaktLocation) Reset() {
+	*x = KontaktLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KontaktLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KontaktLocation) ProtoMessage() {}
+
+func (x *KontaktLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KontaktLocation.ProtoReflect.Descriptor instead.
+func (*KontaktLocation) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *KontaktLocation) GetTxPower() int32 {
+	if x != nil {
+		return x.TxPower
+	}
+	return 0
+}
+
+func (x *KontaktLocation) GetBleChannel() uint32 {
+	if x != nil {
+		return x.BleChannel
+	}
+	return 0
+}
+
+func (x *KontaktLocation) GetDeviceModel() uint32 {
+	if x != nil {
+		return x.DeviceModel
+	}
+	return 0
+}
+
+func (x *KontaktLocation) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *KontaktLocation) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+type KontaktTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []*TelemetryValue `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *KontaktTelemetry) Reset() {
+	*x = KontaktTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KontaktTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KontaktTelemetry) ProtoMessage() {}
+
+func (x *KontaktTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KontaktTelemetry.ProtoReflect.Descriptor instead.
+func (*KontaktTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *KontaktTelemetry) GetFields() []*TelemetryValue {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// TelemetryValue is a raw telemetry field. decoded is set when the field was decoded successfully,
+// it's ignored when converting back to kontaktparser types.
+type TelemetryValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid   TelemetryPID `protobuf:"varint,1,opt,name=pid,proto3,enum=kontaktparser.v1.TelemetryPID" json:"pid,omitempty"`
+	Value []byte       `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Types that are assignable to Decoded:
+	//	*TelemetryValue_SystemHealth
+	//	*TelemetryValue_Accelerometer
+	//	*TelemetryValue_Sensors
+	//	*TelemetryValue_Acceleration
+	//	*TelemetryValue_Movement
+	//	*TelemetryValue_DoubleTap
+	//	*TelemetryValue_LightLevel
+	//	*TelemetryValue_Temperature_8Bit
+	//	*TelemetryValue_Temperature_16Bit
+	//	*TelemetryValue_Battery
+	//	*TelemetryValue_Click
+	//	*TelemetryValue_ClickInfo
+	//	*TelemetryValue_UtcTime
+	//	*TelemetryValue_Humidity
+	//	*TelemetryValue_MovementInfo
+	Decoded isTelemetryValue_Decoded `protobuf_oneof:"decoded"`
+}
+
+func (x *TelemetryValue) Reset() {
+	*x = TelemetryValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TelemetryValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelemetryValue) ProtoMessage() {}
+
+func (x *TelemetryValue) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelemetryValue.ProtoReflect.Descriptor instead.
+func (*TelemetryValue) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TelemetryValue) GetPid() TelemetryPID {
+	if x != nil {
+		return x.Pid
+	}
+	return TelemetryPID_TELEMETRY_PID_UNSPECIFIED
+}
+
+func (x *TelemetryValue) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (m *TelemetryValue) GetDecoded() isTelemetryValue_Decoded {
+	if m != nil {
+		return m.Decoded
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetSystemHealth() *SystemHealthTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_SystemHealth); ok {
+		return x.SystemHealth
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetAccelerometer() *AccelerometerTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_Accelerometer); ok {
+		return x.Accelerometer
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetSensors() *SensorsTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_Sensors); ok {
+		return x.Sensors
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetAcceleration() *AccelerationTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_Acceleration); ok {
+		return x.Acceleration
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetMovement() *MovementTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_Movement); ok {
+		return x.Movement
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetDoubleTap() *DoubleTapTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_DoubleTap); ok {
+		return x.DoubleTap
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetLightLevel() *LightLevelTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_LightLevel); ok {
+		return x.LightLevel
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetTemperature_8Bit() *Temperature8BitTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_Temperature_8Bit); ok {
+		return x.Temperature_8Bit
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetTemperature_16Bit() *Temperature16BitTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_Temperature_16Bit); ok {
+		return x.Temperature_16Bit
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetBattery() *BatteryTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_Battery); ok {
+		return x.Battery
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetClick() *ClickTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_Click); ok {
+		return x.Click
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetClickInfo() *ClickInfoTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_ClickInfo); ok {
+		return x.ClickInfo
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetUtcTime() *UTCTimeTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_UtcTime); ok {
+		return x.UtcTime
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetHumidity() *HumidityTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_Humidity); ok {
+		return x.Humidity
+	}
+	return nil
+}
+
+func (x *TelemetryValue) GetMovementInfo() *MovementInfoTelemetry {
+	if x, ok := x.GetDecoded().(*TelemetryValue_MovementInfo); ok {
+		return x.MovementInfo
+	}
+	return nil
+}
+
+type isTelemetryValue_Decoded interface {
+	isTelemetryValue_Decoded()
+}
+
+type TelemetryValue_SystemHealth struct {
+	SystemHealth *SystemHealthTelemetry `protobuf:"bytes,3,opt,name=system_health,json=systemHealth,proto3,oneof"`
+}
+
+type TelemetryValue_Accelerometer struct {
+	Accelerometer *AccelerometerTelemetry `protobuf:"bytes,4,opt,name=accelerometer,proto3,oneof"`
+}
+
+type TelemetryValue_Sensors struct {
+	Sensors *SensorsTelemetry `protobuf:"bytes,5,opt,name=sensors,proto3,oneof"`
+}
+
+type TelemetryValue_Acceleration struct {
+	Acceleration *AccelerationTelemetry `protobuf:"bytes,6,opt,name=acceleration,proto3,oneof"`
+}
+
+type TelemetryValue_Movement struct {
+	Movement *MovementTelemetry `protobuf:"bytes,7,opt,name=movement,proto3,oneof"`
+}
+
+type TelemetryValue_DoubleTap struct {
+	DoubleTap *DoubleTapTelemetry `protobuf:"bytes,8,opt,name=double_tap,json=doubleTap,proto3,oneof"`
+}
+
+type TelemetryValue_LightLevel struct {
+	LightLevel *LightLevelTelemetry `protobuf:"bytes,9,opt,name=light_level,json=lightLevel,proto3,oneof"`
+}
+
+type TelemetryValue_Temperature_8Bit struct {
+	Temperature_8Bit *Temperature8BitTelemetry `protobuf:"bytes,10,opt,name=temperature_8bit,json=temperature8bit,proto3,oneof"`
+}
+
+type TelemetryValue_Temperature_16Bit struct {
+	Temperature_16Bit *Temperature16BitTelemetry `protobuf:"bytes,11,opt,name=temperature_16bit,json=temperature16bit,proto3,oneof"`
+}
+
+type TelemetryValue_Battery struct {
+	Battery *BatteryTelemetry `protobuf:"bytes,12,opt,name=battery,proto3,oneof"`
+}
+
+type TelemetryValue_Click struct {
+	Click *ClickTelemetry `protobuf:"bytes,13,opt,name=click,proto3,oneof"`
+}
+
+type TelemetryValue_ClickInfo struct {
+	ClickInfo *ClickInfoTelemetry `protobuf:"bytes,14,opt,name=click_info,json=clickInfo,proto3,oneof"`
+}
+
+type TelemetryValue_UtcTime struct {
+	UtcTime *UTCTimeTelemetry `protobuf:"bytes,15,opt,name=utc_time,json=utcTime,proto3,oneof"`
+}
+
+type TelemetryValue_Humidity struct {
+	Humidity *HumidityTelemetry `protobuf:"bytes,16,opt,name=humidity,proto3,oneof"`
+}
+
+type TelemetryValue_MovementInfo struct {
+	MovementInfo *MovementInfoTelemetry `protobuf:"bytes,17,opt,name=movement_info,json=movementInfo,proto3,oneof"`
+}
+
+func (*TelemetryValue_SystemHealth) isTelemetryValue_Decoded() {}
+
+func (*TelemetryValue_Accelerometer) isTelemetryValue_Decoded() {}
+
+func (*TelemetryValue_Sensors) isTelemetryValue_Decoded() {}
+
+func (*TelemetryValue_Acceleration) isTelemetryValue_Decoded() {}
+
+func (*TelemetryValue_Movement) isTelemetryValue_Decoded() {}
+
+func (*TelemetryValue_DoubleTap) isTelemetryValue_Decoded() {}
+
+func (*TelemetryValue_LightLevel) isTelemetryValue_Decoded() {}
+
+func (*TelemetryValue_Temperature_8Bit) isTelemetryValue_Decoded() {}
+
+func (*TelemetryValue_Temperature_16Bit) isTelemetryValue_Decoded() {}
+
+func (*TelemetryValue_Battery) isTelemetryValue_Decoded() {}
+
+func (*TelemetryValue_Click) isTelemetryValue_Decoded() {}
+
+func (*TelemetryValue_ClickInfo) isTelemetryValue_Decoded() {}
+
+func (*TelemetryValue_UtcTime) isTelemetryValue_Decoded() {}
+
+func (*TelemetryValue_Humidity) isTelemetryValue_Decoded() {}
+
+func (*TelemetryValue_MovementInfo) isTelemetryValue_Decoded() {}
+
+type SystemHealthTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnixTimestamp uint32 `protobuf:"varint,1,opt,name=unix_timestamp,json=unixTimestamp,proto3" json:"unix_timestamp,omitempty"`
+	BatteryLevel  uint32 `protobuf:"varint,2,opt,name=battery_level,json=batteryLevel,proto3" json:"battery_level,omitempty"`
+}
+
+func (x *SystemHealthTelemetry) Reset() {
+	*x = SystemHealthTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemHealthTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemHealthTelemetry) ProtoMessage() {}
+
+func (x *SystemHealthTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemHealthTelemetry.ProtoReflect.Descriptor instead.
+func (*SystemHealthTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SystemHealthTelemetry) GetUnixTimestamp() uint32 {
+	if x != nil {
+		return x.UnixTimestamp
+	}
+	return 0
+}
+
+func (x *SystemHealthTelemetry) GetBatteryLevel() uint32 {
+	if x != nil {
+		return x.BatteryLevel
+	}
+	return 0
+}
+
+type AccelerometerTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sensitivity           uint32 `protobuf:"varint,1,opt,name=sensitivity,proto3" json:"sensitivity,omitempty"`
+	X                     int32  `protobuf:"zigzag32,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y                     int32  `protobuf:"zigzag32,3,opt,name=y,proto3" json:"y,omitempty"`
+	Z                     int32  `protobuf:"zigzag32,4,opt,name=z,proto3" json:"z,omitempty"`
+	SecondsSinceDoubleTap uint32 `protobuf:"varint,5,opt,name=seconds_since_double_tap,json=secondsSinceDoubleTap,proto3" json:"seconds_since_double_tap,omitempty"`
+	SecondsSinceThreshold uint32 `protobuf:"varint,6,opt,name=seconds_since_threshold,json=secondsSinceThreshold,proto3" json:"seconds_since_threshold,omitempty"`
+}
+
+func (x *AccelerometerTelemetry) Reset() {
+	*x = AccelerometerTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccelerometerTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccelerometerTelemetry) ProtoMessage() {}
+
+func (x *AccelerometerTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccelerometerTelemetry.ProtoReflect.Descriptor instead.
+func (*AccelerometerTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AccelerometerTelemetry) GetSensitivity() uint32 {
+	if x != nil {
+		return x.Sensitivity
+	}
+	return 0
+}
+
+func (x *AccelerometerTelemetry) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *AccelerometerTelemetry) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *AccelerometerTelemetry) GetZ() int32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *AccelerometerTelemetry) GetSecondsSinceDoubleTap() uint32 {
+	if x != nil {
+		return x.SecondsSinceDoubleTap
+	}
+	return 0
+}
+
+func (x *AccelerometerTelemetry) GetSecondsSinceThreshold() uint32 {
+	if x != nil {
+		return x.SecondsSinceThreshold
+	}
+	return 0
+}
+
+type SensorsTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LightLevel  uint32 `protobuf:"varint,1,opt,name=light_level,json=lightLevel,proto3" json:"light_level,omitempty"`
+	Temperature int32  `protobuf:"zigzag32,2,opt,name=temperature,proto3" json:"temperature,omitempty"`
+}
+
+func (x *SensorsTelemetry) Reset() {
+	*x = SensorsTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SensorsTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorsTelemetry) ProtoMessage() {}
+
+func (x *SensorsTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorsTelemetry.ProtoReflect.Descriptor instead.
+func (*SensorsTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SensorsTelemetry) GetLightLevel() uint32 {
+	if x != nil {
+		return x.LightLevel
+	}
+	return 0
+}
+
+func (x *SensorsTelemetry) GetTemperature() int32 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+type AccelerationTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sensitivity uint32 `protobuf:"varint,1,opt,name=sensitivity,proto3" json:"sensitivity,omitempty"`
+	X           int32  `protobuf:"zigzag32,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y           int32  `protobuf:"zigzag32,3,opt,name=y,proto3" json:"y,omitempty"`
+	Z           int32  `protobuf:"zigzag32,4,opt,name=z,proto3" json:"z,omitempty"`
+}
+
+func (x *AccelerationTelemetry) Reset() {
+	*x = AccelerationTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccelerationTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccelerationTelemetry) ProtoMessage() {}
+
+func (x *AccelerationTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccelerationTelemetry.ProtoReflect.Descriptor instead.
+func (*AccelerationTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AccelerationTelemetry) GetSensitivity() uint32 {
+	if x != nil {
+		return x.Sensitivity
+	}
+	return 0
+}
+
+func (x *AccelerationTelemetry) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *AccelerationTelemetry) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *AccelerationTelemetry) GetZ() int32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+type MovementTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecondsSinceThreshold uint32 `protobuf:"varint,1,opt,name=seconds_since_threshold,json=secondsSinceThreshold,proto3" json:"seconds_since_threshold,omitempty"`
+}
+
+func (x *MovementTelemetry) Reset() {
+	*x = MovementTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MovementTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovementTelemetry) ProtoMessage() {}
+
+func (x *MovementTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovementTelemetry.ProtoReflect.Descriptor instead.
+func (*MovementTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MovementTelemetry) GetSecondsSinceThreshold() uint32 {
+	if x != nil {
+		return x.SecondsSinceThreshold
+	}
+	return 0
+}
+
+type DoubleTapTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecondsSinceDoubleTap uint32 `protobuf:"varint,1,opt,name=seconds_since_double_tap,json=secondsSinceDoubleTap,proto3" json:"seconds_since_double_tap,omitempty"`
+}
+
+func (x *DoubleTapTelemetry) Reset() {
+	*x = DoubleTapTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoubleTapTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleTapTelemetry) ProtoMessage() {}
+
+func (x *DoubleTapTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleTapTelemetry.ProtoReflect.Descriptor instead.
+func (*DoubleTapTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DoubleTapTelemetry) GetSecondsSinceDoubleTap() uint32 {
+	if x != nil {
+		return x.SecondsSinceDoubleTap
+	}
+	return 0
+}
+
+type LightLevelTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LightLevel uint32 `protobuf:"varint,1,opt,name=light_level,json=lightLevel,proto3" json:"light_level,omitempty"`
+}
+
+func (x *LightLevelTelemetry) Reset() {
+	*x = LightLevelTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LightLevelTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LightLevelTelemetry) ProtoMessage() {}
+
+func (x *LightLevelTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LightLevelTelemetry.ProtoReflect.Descriptor instead.
+func (*LightLevelTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LightLevelTelemetry) GetLightLevel() uint32 {
+	if x != nil {
+		return x.LightLevel
+	}
+	return 0
+}
+
+type Temperature8BitTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Temperature int32 `protobuf:"zigzag32,1,opt,name=temperature,proto3" json:"temperature,omitempty"`
+}
+
+func (x *Temperature8BitTelemetry) Reset() {
+	*x = Temperature8BitTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Temperature8BitTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Temperature8BitTelemetry) ProtoMessage() {}
+
+func (x *Temperature8BitTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Temperature8BitTelemetry.ProtoReflect.Descriptor instead.
+func (*Temperature8BitTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Temperature8BitTelemetry) GetTemperature() int32 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+type Temperature16BitTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Temperature float32 `protobuf:"fixed32,1,opt,name=temperature,proto3" json:"temperature,omitempty"`
+}
+
+func (x *Temperature16BitTelemetry) Reset() {
+	*x = Temperature16BitTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Temperature16BitTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Temperature16BitTelemetry) ProtoMessage() {}
+
+func (x *Temperature16BitTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Temperature16BitTelemetry.ProtoReflect.Descriptor instead.
+func (*Temperature16BitTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Temperature16BitTelemetry) GetTemperature() float32 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+type BatteryTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatteryLevel uint32 `protobuf:"varint,1,opt,name=battery_level,json=batteryLevel,proto3" json:"battery_level,omitempty"`
+}
+
+func (x *BatteryTelemetry) Reset() {
+	*x = BatteryTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatteryTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatteryTelemetry) ProtoMessage() {}
+
+func (x *BatteryTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatteryTelemetry.ProtoReflect.Descriptor instead.
+func (*BatteryTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatteryTelemetry) GetBatteryLevel() uint32 {
+	if x != nil {
+		return x.BatteryLevel
+	}
+	return 0
+}
+
+type ClickTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecondsSinceClick uint32 `protobuf:"varint,1,opt,name=seconds_since_click,json=secondsSinceClick,proto3" json:"seconds_since_click,omitempty"`
+}
+
+func (x *ClickTelemetry) Reset() {
+	*x = ClickTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClickTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClickTelemetry) ProtoMessage() {}
+
+func (x *ClickTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClickTelemetry.ProtoReflect.Descriptor instead.
+func (*ClickTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ClickTelemetry) GetSecondsSinceClick() uint32 {
+	if x != nil {
+		return x.SecondsSinceClick
+	}
+	return 0
+}
+
+type ClickInfoTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClickId           uint32 `protobuf:"varint,1,opt,name=click_id,json=clickId,proto3" json:"click_id,omitempty"`
+	SecondsSinceClick uint32 `protobuf:"varint,2,opt,name=seconds_since_click,json=secondsSinceClick,proto3" json:"seconds_since_click,omitempty"`
+}
+
+func (x *ClickInfoTelemetry) Reset() {
+	*x = ClickInfoTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClickInfoTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClickInfoTelemetry) ProtoMessage() {}
+
+func (x *ClickInfoTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClickInfoTelemetry.ProtoReflect.Descriptor instead.
+func (*ClickInfoTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ClickInfoTelemetry) GetClickId() uint32 {
+	if x != nil {
+		return x.ClickId
+	}
+	return 0
+}
+
+func (x *ClickInfoTelemetry) GetSecondsSinceClick() uint32 {
+	if x != nil {
+		return x.SecondsSinceClick
+	}
+	return 0
+}
+
+type UTCTimeTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UtcTime uint32 `protobuf:"varint,1,opt,name=utc_time,json=utcTime,proto3" json:"utc_time,omitempty"`
+}
+
+func (x *UTCTimeTelemetry) Reset() {
+	*x = UTCTimeTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UTCTimeTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTCTimeTelemetry) ProtoMessage() {}
+
+func (x *UTCTimeTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UTCTimeTelemetry.ProtoReflect.Descriptor instead.
+func (*UTCTimeTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UTCTimeTelemetry) GetUtcTime() uint32 {
+	if x != nil {
+		return x.UtcTime
+	}
+	return 0
+}
+
+type HumidityTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Humidity uint32 `protobuf:"varint,1,opt,name=humidity,proto3" json:"humidity,omitempty"`
+}
+
+func (x *HumidityTelemetry) Reset() {
+	*x = HumidityTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HumidityTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HumidityTelemetry) ProtoMessage() {}
+
+func (x *HumidityTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HumidityTelemetry.ProtoReflect.Descriptor instead.
+func (*HumidityTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *HumidityTelemetry) GetHumidity() uint32 {
+	if x != nil {
+		return x.Humidity
+	}
+	return 0
+}
+
+type MovementInfoTelemetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counter               uint32 `protobuf:"varint,1,opt,name=counter,proto3" json:"counter,omitempty"`
+	SecondsSinceThreshold uint32 `protobuf:"varint,2,opt,name=seconds_since_threshold,json=secondsSinceThreshold,proto3" json:"seconds_since_threshold,omitempty"`
+}
+
+func (x *MovementInfoTelemetry) Reset() {
+	*x = MovementInfoTelemetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MovementInfoTelemetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovementInfoTelemetry) ProtoMessage() {}
+
+func (x *MovementInfoTelemetry) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovementInfoTelemetry.ProtoReflect.Descriptor instead.
+func (*MovementInfoTelemetry) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MovementInfoTelemetry) GetCounter() uint32 {
+	if x != nil {
+		return x.Counter
+	}
+	return 0
+}
+
+func (x *MovementInfoTelemetry) GetSecondsSinceThreshold() uint32 {
+	if x != nil {
+		return x.SecondsSinceThreshold
+	}
+	return 0
+}
+
+type EddystoneUID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxPower_0M int32  `protobuf:"zigzag32,1,opt,name=tx_power_0m,json=txPower0m,proto3" json:"tx_power_0m,omitempty"`
+	Namespace  []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	InstanceId []byte `protobuf:"bytes,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+}
+
+func (x *EddystoneUID) Reset() {
+	*x = EddystoneUID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddystoneUID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddystoneUID) ProtoMessage() {}
+
+func (x *EddystoneUID) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddystoneUID.ProtoReflect.Descriptor instead.
+func (*EddystoneUID) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EddystoneUID) GetTxPower_0M() int32 {
+	if x != nil {
+		return x.TxPower_0M
+	}
+	return 0
+}
+
+func (x *EddystoneUID) GetNamespace() []byte {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *EddystoneUID) GetInstanceId() []byte {
+	if x != nil {
+		return x.InstanceId
+	}
+	return nil
+}
+
+type EddystoneURL struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxPower_0M int32  `protobuf:"zigzag32,1,opt,name=tx_power_0m,json=txPower0m,proto3" json:"tx_power_0m,omitempty"`
+	Url        string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *EddystoneURL) Reset() {
+	*x = EddystoneURL{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddystoneURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddystoneURL) ProtoMessage() {}
+
+func (x *EddystoneURL) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddystoneURL.ProtoReflect.Descriptor instead.
+func (*EddystoneURL) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EddystoneURL) GetTxPower_0M() int32 {
+	if x != nil {
+		return x.TxPower_0M
+	}
+	return 0
+}
+
+func (x *EddystoneURL) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type EddystoneTLM struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatteryVoltage     uint32  `protobuf:"varint,1,opt,name=battery_voltage,json=batteryVoltage,proto3" json:"battery_voltage,omitempty"`
+	Temperature        float64 `protobuf:"fixed64,2,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	AdvertisementCount uint32  `protobuf:"varint,3,opt,name=advertisement_count,json=advertisementCount,proto3" json:"advertisement_count,omitempty"`
+	TimeSincePowerOn   float64 `protobuf:"fixed64,4,opt,name=time_since_power_on,json=timeSincePowerOn,proto3" json:"time_since_power_on,omitempty"`
+}
+
+func (x *EddystoneTLM) Reset() {
+	*x = EddystoneTLM{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddystoneTLM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddystoneTLM) ProtoMessage() {}
+
+func (x *EddystoneTLM) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddystoneTLM.ProtoReflect.Descriptor instead.
+func (*EddystoneTLM) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EddystoneTLM) GetBatteryVoltage() uint32 {
+	if x != nil {
+		return x.BatteryVoltage
+	}
+	return 0
+}
+
+func (x *EddystoneTLM) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *EddystoneTLM) GetAdvertisementCount() uint32 {
+	if x != nil {
+		return x.AdvertisementCount
+	}
+	return 0
+}
+
+func (x *EddystoneTLM) GetTimeSincePowerOn() float64 {
+	if x != nil {
+		return x.TimeSincePowerOn
+	}
+	return 0
+}
+
+type EddystoneETLM struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Telemetry []byte `protobuf:"bytes,1,opt,name=telemetry,proto3" json:"telemetry,omitempty"`
+	Salt      []byte `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty"`
+	Mic       []byte `protobuf:"bytes,3,opt,name=mic,proto3" json:"mic,omitempty"`
+}
+
+func (x *EddystoneETLM) Reset() {
+	*x = EddystoneETLM{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddystoneETLM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddystoneETLM) ProtoMessage() {}
+
+func (x *EddystoneETLM) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddystoneETLM.ProtoReflect.Descriptor instead.
+func (*EddystoneETLM) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EddystoneETLM) GetTelemetry() []byte {
+	if x != nil {
+		return x.Telemetry
+	}
+	return nil
+}
+
+func (x *EddystoneETLM) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *EddystoneETLM) GetMic() []byte {
+	if x != nil {
+		return x.Mic
+	}
+	return nil
+}
+
+type EddystoneEID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxPower_0M int32  `protobuf:"zigzag32,1,opt,name=tx_power_0m,json=txPower0m,proto3" json:"tx_power_0m,omitempty"`
+	Eid        []byte `protobuf:"bytes,2,opt,name=eid,proto3" json:"eid,omitempty"`
+}
+
+func (x *EddystoneEID) Reset() {
+	*x = EddystoneEID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddystoneEID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddystoneEID) ProtoMessage() {}
+
+func (x *EddystoneEID) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddystoneEID.ProtoReflect.Descriptor instead.
+func (*EddystoneEID) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EddystoneEID) GetTxPower_0M() int32 {
+	if x != nil {
+		return x.TxPower_0M
+	}
+	return 0
+}
+
+func (x *EddystoneEID) GetEid() []byte {
+	if x != nil {
+		return x.Eid
+	}
+	return nil
+}
+
+type EddystoneUnknown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FrameType uint32 `protobuf:"varint,1,opt,name=frame_type,json=frameType,proto3" json:"frame_type,omitempty"`
+	Payload   []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *EddystoneUnknown) Reset() {
+	*x = EddystoneUnknown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_frames_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EddystoneUnknown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EddystoneUnknown) ProtoMessage() {}
+
+func (x *EddystoneUnknown) ProtoReflect() protoreflect.Message {
+	mi := &file_frames_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EddystoneUnknown.ProtoReflect.Descriptor instead.
+func (*EddystoneUnknown) Descriptor() ([]byte, []int) {
+	return file_frames_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *EddystoneUnknown) GetFrameType() uint32 {
+	if x != nil {
+		return x.FrameType
+	}
+	return 0
+}
+
+func (x *EddystoneUnknown) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+var File_frames_proto protoreflect.FileDescriptor
+
+var file_frames_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10,
+	0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x22, 0xe1, 0x07, 0x0a, 0x05, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61,
+	0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x69, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70,
+	0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x07, 0x69, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x15, 0x6b,
+	0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x6f, 0x6e,
+	0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x6f,
+	0x6e, 0x74, 0x61, 0x6b, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x13, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x53, 0x63, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0d, 0x6b, 0x6f, 0x6e, 0x74,
+	0x61, 0x6b, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x48,
+	0x00, 0x52, 0x0c, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x12,
+	0x4e, 0x0a, 0x10, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x5f, 0x73, 0x68, 0x75, 0x66, 0x66,
+	0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6b, 0x6f, 0x6e, 0x74,
+	0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x6f, 0x6e,
+	0x74, 0x61, 0x6b, 0x74, 0x53, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f,
+	0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x53, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x64, 0x12,
+	0x51, 0x0a, 0x11, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x5f, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6b, 0x6f, 0x6e,
+	0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x6f,
+	0x6e, 0x74, 0x61, 0x6b, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x48, 0x00,
+	0x52, 0x10, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x12, 0x4e, 0x0a, 0x10, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6b,
+	0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x0f, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0d, 0x65, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x5f,
+	0x75, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x6f, 0x6e, 0x74,
+	0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x64,
+	0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x55, 0x49, 0x44, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x64, 0x64,
+	0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x55, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x0d, 0x65, 0x64, 0x64,
+	0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x55, 0x52, 0x4c,
+	0x48, 0x00, 0x52, 0x0c, 0x65, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x55, 0x72, 0x6c,
+	0x12, 0x45, 0x0a, 0x0d, 0x65, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x6c,
+	0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b,
+	0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x64, 0x79, 0x73,
+	0x74, 0x6f, 0x6e, 0x65, 0x54, 0x4c, 0x4d, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x64, 0x64, 0x79, 0x73,
+	0x74, 0x6f, 0x6e, 0x65, 0x54, 0x6c, 0x6d, 0x12, 0x48, 0x0a, 0x0e, 0x65, 0x64, 0x64, 0x79, 0x73,
+	0x74, 0x6f, 0x6e, 0x65, 0x5f, 0x65, 0x74, 0x6c, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x45, 0x54, 0x4c, 0x4d,
+	0x48, 0x00, 0x52, 0x0d, 0x65, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x45, 0x74, 0x6c,
+	0x6d, 0x12, 0x45, 0x0a, 0x0d, 0x65, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x5f, 0x65,
+	0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61,
+	0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x64, 0x79,
+	0x73, 0x74, 0x6f, 0x6e, 0x65, 0x45, 0x49, 0x44, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x64, 0x64, 0x79,
+	0x73, 0x74, 0x6f, 0x6e, 0x65, 0x45, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x11, 0x65, 0x64, 0x64, 0x79,
+	0x73, 0x74, 0x6f, 0x6e, 0x65, 0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65,
+	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x65, 0x64, 0x64, 0x79, 0x73,
+	0x74, 0x6f, 0x6e, 0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x07, 0x49, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72,
+	0x73, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x11, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x52, 0x73, 0x73, 0x69, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f,
+	0x78, 0x69, 0x6d, 0x69, 0x74, 0x79, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x69, 0x74, 0x79, 0x55, 0x75, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x22, 0xcc, 0x02, 0x0a,
+	0x13, 0x4b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x11, 0x52, 0x07, 0x74, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x20,
+	0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x54, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x68, 0x61, 0x73, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x10, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65,
+	0x64, 0x5f, 0x69, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x68, 0x75, 0x66,
+	0x66, 0x6c, 0x65, 0x64, 0x49, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x22, 0xdc, 0x01, 0x0a, 0x0c,
+	0x4b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
+	0x25, 0x0a, 0x0e, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x6d, 0x61, 0x6a, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72,
+	0x65, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61,
+	0x72, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x23, 0x0a,
+	0x0d, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x11, 0x52, 0x07, 0x74, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x22, 0xa7, 0x02, 0x0a, 0x0f, 0x4b,
+	0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x53, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x6d, 0x61,
+	0x6a, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x69, 0x72, 0x6d, 0x77,
+	0x61, 0x72, 0x65, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x69, 0x72, 0x6d,
+	0x77, 0x61, 0x72, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x12,
+	0x23, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x11, 0x52, 0x07, 0x74, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12,
+	0x2f, 0x0a, 0x13, 0x65, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x65, 0x64,
+	0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x32, 0x0a, 0x15, 0x65, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x13, 0x65, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x0f, 0x4b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x11, 0x52, 0x07, 0x74, 0x78, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x10, 0x4b, 0x6f,
+	0x6e, 0x74, 0x61, 0x6b, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x38,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xb2, 0x09, 0x0a, 0x0e, 0x54, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x30, 0x0a, 0x03, 0x70,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61,
+	0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x50, 0x49, 0x44, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x6f, 0x6e,
+	0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x50, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x6f, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x6f, 0x6e,
+	0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x6c, 0x65, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x6f,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74,
+	0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72,
+	0x73, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x07, 0x73, 0x65,
+	0x6e, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x6f,
+	0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74,
+	0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x08, 0x6d,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x0a, 0x64, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x5f, 0x74, 0x61, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6b, 0x6f,
+	0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x70, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x48, 0x00, 0x52, 0x09, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x70, 0x12, 0x48,
+	0x0a, 0x0b, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72,
+	0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x57, 0x0a, 0x10, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x38, 0x62, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x38, 0x42, 0x69, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x48, 0x00,
+	0x52, 0x0f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x38, 0x62, 0x69,
+	0x74, 0x12, 0x5a, 0x0a, 0x11, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x31, 0x36, 0x62, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6b,
+	0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31, 0x36, 0x42, 0x69, 0x74,
+	0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x10, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31, 0x36, 0x62, 0x69, 0x74, 0x12, 0x3e, 0x0a,
+	0x07, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x48, 0x00, 0x52, 0x07, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12, 0x38, 0x0a,
+	0x05, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b,
+	0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x48, 0x00,
+	0x52, 0x05, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x12, 0x45, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x63, 0x6b,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6b, 0x6f,
+	0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6c, 0x69, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f,
+	0x0a, 0x08, 0x75, 0x74, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x54, 0x43, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x07, 0x75, 0x74, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x41, 0x0a, 0x08, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x54, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x08, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x12, 0x4e, 0x0a, 0x0d, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x6f, 0x6e, 0x74,
+	0x61, 0x6b, 0x74, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x22, 0x63, 0x0a,
+	0x15, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x75, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a,
+	0x0d, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x22, 0xd5, 0x01, 0x0a, 0x16, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x6f, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x20, 0x0a,
+	0x0b, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12,
+	0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x11, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a,
+	0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x11, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x11, 0x52, 0x01, 0x7a, 0x12, 0x37, 0x0a, 0x18, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x5f, 0x74, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x54,
+	0x61, 0x70, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x15, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63,
+	0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x55, 0x0a, 0x10, 0x53, 0x65,
+	0x6e, 0x73, 0x6f, 0x72, 0x73, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x11, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0x63, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x0c, 0x0a, 0x01,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x11, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x11, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x11, 0x52, 0x01, 0x7a, 0x22, 0x4b, 0x0a, 0x11, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x22, 0x4d, 0x0a, 0x12, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x70,
+	0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x5f, 0x74, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x54,
+	0x61, 0x70, 0x22, 0x36, 0x0a, 0x13, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x3c, 0x0a, 0x18, 0x54, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x38, 0x42, 0x69, 0x74, 0x54, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x11, 0x52, 0x0b, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3d, 0x0a, 0x19, 0x54, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x31, 0x36, 0x42, 0x69, 0x74, 0x54, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x37, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x62,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x22, 0x40, 0x0a, 0x0e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x11, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x43, 0x6c, 0x69,
+	0x63, 0x6b, 0x22, 0x5f, 0x0a, 0x12, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x54,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x63,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x63,
+	0x6b, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x11, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x43, 0x6c,
+	0x69, 0x63, 0x6b, 0x22, 0x2d, 0x0a, 0x10, 0x55, 0x54, 0x43, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x74, 0x63, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x74, 0x63, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x11, 0x48, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x54, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x75, 0x6d, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x75, 0x6d, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x22, 0x69, 0x0a, 0x15, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x53, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x6d,
+	0x0a, 0x0c, 0x45, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x55, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0b, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x30, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x11, 0x52, 0x09, 0x74, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x30, 0x6d, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x40, 0x0a,
+	0x0c, 0x45, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x1e, 0x0a,
+	0x0b, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x30, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x11, 0x52, 0x09, 0x74, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x30, 0x6d, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22,
+	0xb9, 0x01, 0x0a, 0x0c, 0x45, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x54, 0x4c, 0x4d,
+	0x12, 0x27, 0x0a, 0x0f, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x6f, 0x6c, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x62, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x79, 0x56, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x61,
+	0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74,
+	0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x13,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x22, 0x53, 0x0a, 0x0d, 0x45,
+	0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x45, 0x54, 0x4c, 0x4d, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61,
+	0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6d, 0x69, 0x63,
+	0x22, 0x40, 0x0a, 0x0c, 0x45, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x45, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x30, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x11, 0x52, 0x09, 0x74, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x30, 0x6d,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x65,
+	0x69, 0x64, 0x22, 0x4b, 0x0a, 0x10, 0x45, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x55,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2a,
+	0xc6, 0x03, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x19, 0x0a, 0x15, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x44,
+	0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x42, 0x45,
+	0x41, 0x43, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x44, 0x44, 0x59, 0x53, 0x54, 0x4f, 0x4e,
+	0x45, 0x5f, 0x55, 0x49, 0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x54, 0x45, 0x43,
+	0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x44, 0x44, 0x59, 0x53, 0x54, 0x4f,
+	0x4e, 0x45, 0x5f, 0x55, 0x52, 0x4c, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x54, 0x45,
+	0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x44, 0x44, 0x59, 0x53, 0x54,
+	0x4f, 0x4e, 0x45, 0x5f, 0x54, 0x4c, 0x4d, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x54,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x44, 0x44, 0x59, 0x53,
+	0x54, 0x4f, 0x4e, 0x45, 0x5f, 0x45, 0x49, 0x44, 0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x45,
+	0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x44, 0x44, 0x59,
+	0x53, 0x54, 0x4f, 0x4e, 0x45, 0x5f, 0x45, 0x54, 0x4c, 0x4d, 0x10, 0x06, 0x12, 0x27, 0x0a, 0x23,
+	0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x4f,
+	0x4e, 0x54, 0x41, 0x4b, 0x54, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f,
+	0x4e, 0x53, 0x45, 0x10, 0x07, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45,
+	0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x4f, 0x4e, 0x54, 0x41, 0x4b, 0x54, 0x5f, 0x50,
+	0x4c, 0x41, 0x49, 0x4e, 0x10, 0x08, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x4f, 0x4e, 0x54, 0x41, 0x4b, 0x54, 0x5f,
+	0x53, 0x48, 0x55, 0x46, 0x46, 0x4c, 0x45, 0x44, 0x10, 0x09, 0x12, 0x23, 0x0a, 0x1f, 0x44, 0x45,
+	0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x4f, 0x4e, 0x54,
+	0x41, 0x4b, 0x54, 0x5f, 0x54, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x10, 0x0a, 0x12,
+	0x22, 0x0a, 0x1e, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4b, 0x4f, 0x4e, 0x54, 0x41, 0x4b, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x0b, 0x12, 0x23, 0x0a, 0x1f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x44, 0x44, 0x59, 0x53, 0x54, 0x4f, 0x4e, 0x45, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x0c, 0x2a, 0xfd, 0x03, 0x0a, 0x0c, 0x54, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x50, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x45, 0x4c,
+	0x45, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x50, 0x49, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x45, 0x4c, 0x45,
+	0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x50, 0x49, 0x44, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d,
+	0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x45, 0x4c,
+	0x45, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x50, 0x49, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x4c,
+	0x45, 0x52, 0x4f, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x45,
+	0x4c, 0x45, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x50, 0x49, 0x44, 0x5f, 0x53, 0x45, 0x4e, 0x53,
+	0x4f, 0x52, 0x53, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x54,
+	0x52, 0x59, 0x5f, 0x50, 0x49, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x4c, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x54,
+	0x52, 0x59, 0x5f, 0x50, 0x49, 0x44, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10,
+	0x07, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x50,
+	0x49, 0x44, 0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x54, 0x41, 0x50, 0x10, 0x08, 0x12,
+	0x1d, 0x0a, 0x19, 0x54, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x50, 0x49, 0x44,
+	0x5f, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x10, 0x0a, 0x12, 0x22,
+	0x0a, 0x1e, 0x54, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x50, 0x49, 0x44, 0x5f,
+	0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x38, 0x42, 0x49, 0x54,
+	0x10, 0x0b, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f,
+	0x50, 0x49, 0x44, 0x5f, 0x42, 0x41, 0x54, 0x54, 0x45, 0x52, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45,
+	0x4c, 0x10, 0x0c, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x54, 0x52, 0x59,
+	0x5f, 0x50, 0x49, 0x44, 0x5f, 0x43, 0x4c, 0x49, 0x43, 0x4b, 0x10, 0x0d, 0x12, 0x1a, 0x0a, 0x16,
+	0x54, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x50, 0x49, 0x44, 0x5f, 0x55, 0x54,
+	0x43, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x0f, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x45, 0x4c, 0x45,
+	0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x50, 0x49, 0x44, 0x5f, 0x43, 0x4c, 0x49, 0x43, 0x4b, 0x5f,
+	0x49, 0x4e, 0x46, 0x4f, 0x10, 0x11, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x45, 0x4c, 0x45, 0x4d, 0x45,
+	0x54, 0x52, 0x59, 0x5f, 0x50, 0x49, 0x44, 0x5f, 0x48, 0x55, 0x4d, 0x49, 0x44, 0x49, 0x54, 0x59,
+	0x10, 0x12, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x5f,
+	0x50, 0x49, 0x44, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f,
+	0x31, 0x36, 0x42, 0x49, 0x54, 0x10, 0x13, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x45, 0x4c, 0x45, 0x4d,
+	0x45, 0x54, 0x52, 0x59, 0x5f, 0x50, 0x49, 0x44, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x16, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x7a, 0x33, 0x33, 0x70, 0x73, 0x7a, 0x2f, 0x6b,
+	0x6f, 0x6e, 0x74, 0x61, 0x6b, 0x74, 0x2d, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2d, 0x70, 0x61,
+	0x72, 0x73, 0x65, 0x72, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_frames_proto_rawDescOnce sync.Once
+	file_frames_proto_rawDescData = file_frames_proto_rawDesc
+)
+
+func file_frames_proto_rawDescGZIP() []byte {
+	file_frames_proto_rawDescOnce.Do(func() {
+		file_frames_proto_rawDescData = protoimpl.X.CompressGZIP(file_frames_proto_rawDescData)
+	})
+	return file_frames_proto_rawDescData
+}
+
+var file_frames_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_frames_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_frames_proto_goTypes = []interface{}{
+	(DetectedType)(0),                 // 0: kontaktparser.v1.DetectedType
+	(TelemetryPID)(0),                 // 1: kontaktparser.v1.TelemetryPID
+	(*Frame)(nil),                     // 2: kontaktparser.v1.Frame
+	(*IBeacon)(nil),                   // 3: kontaktparser.v1.IBeacon
+	(*KontaktScanResponse)(nil),       // 4: kontaktparser.v1.KontaktScanResponse
+	(*KontaktPlain)(nil),              // 5: kontaktparser.v1.KontaktPlain
+	(*KontaktShuffled)(nil),           // 6: kontaktparser.v1.KontaktShuffled
+	(*KontaktLocation)(nil),           // 7: kontaktparser.v1.KontaktLocation
+	(*KontaktTelemetry)(nil),          // 8: kontaktparser.v1.KontaktTelemetry
+	(*TelemetryValue)(nil),            // 9: kontaktparser.v1.TelemetryValue
+	(*SystemHealthTelemetry)(nil),     // 10: kontaktparser.v1.SystemHealthTelemetry
+	(*AccelerometerTelemetry)(nil),    // 11: kontaktparser.v1.AccelerometerTelemetry
+	(*SensorsTelemetry)(nil),          // 12: kontaktparser.v1.SensorsTelemetry
+	(*AccelerationTelemetry)(nil),     // 13: kontaktparser.v1.AccelerationTelemetry
+	(*MovementTelemetry)(nil),         // 14: kontaktparser.v1.MovementTelemetry
+	(*DoubleTapTelemetry)(nil),        // 15: kontaktparser.v1.DoubleTapTelemetry
+	(*LightLevelTelemetry)(nil),       // 16: kontaktparser.v1.LightLevelTelemetry
+	(*Temperature8BitTelemetry)(nil),  // 17: kontaktparser.v1.Temperature8BitTelemetry
+	(*Temperature16BitTelemetry)(nil), // 18: kontaktparser.v1.Temperature16BitTelemetry
+	(*BatteryTelemetry)(nil),          // 19: kontaktparser.v1.BatteryTelemetry
+	(*ClickTelemetry)(nil),            // 20: kontaktparser.v1.ClickTelemetry
+	(*ClickInfoTelemetry)(nil),        // 21: kontaktparser.v1.ClickInfoTelemetry
+	(*UTCTimeTelemetry)(nil),          // 22: kontaktparser.v1.UTCTimeTelemetry
+	(*HumidityTelemetry)(nil),         // 23: kontaktparser.v1.HumidityTelemetry
+	(*MovementInfoTelemetry)(nil),     // 24: kontaktparser.v1.MovementInfoTelemetry
+	(*EddystoneUID)(nil),              // 25: kontaktparser.v1.EddystoneUID
+	(*EddystoneURL)(nil),              // 26: kontaktparser.v1.EddystoneURL
+	(*EddystoneTLM)(nil),              // 27: kontaktparser.v1.EddystoneTLM
+	(*EddystoneETLM)(nil),             // 28: kontaktparser.v1.EddystoneETLM
+	(*EddystoneEID)(nil),              // 29: kontaktparser.v1.EddystoneEID
+	(*EddystoneUnknown)(nil),          // 30: kontaktparser.v1.EddystoneUnknown
+}
+var file_frames_proto_depIdxs = []int32{
+	0,  // 0: kontaktparser.v1.Frame.type:type_name -> kontaktparser.v1.DetectedType
+	3,  // 1: kontaktparser.v1.Frame.ibeacon:type_name -> kontaktparser.v1.IBeacon
+	4,  // 2: kontaktparser.v1.Frame.kontakt_scan_response:type_name -> kontaktparser.v1.KontaktScanResponse
+	5,  // 3: kontaktparser.v1.Frame.kontakt_plain:type_name -> kontaktparser.v1.KontaktPlain
+	6,  // 4: kontaktparser.v1.Frame.kontakt_shuffled:type_name -> kontaktparser.v1.KontaktShuffled
+	8,  // 5: kontaktparser.v1.Frame.kontakt_telemetry:type_name -> kontaktparser.v1.KontaktTelemetry
+	7,  // 6: kontaktparser.v1.Frame.kontakt_location:type_name -> kontaktparser.v1.KontaktLocation
+	25, // 7: kontaktparser.v1.Frame.eddystone_uid:type_name -> kontaktparser.v1.EddystoneUID
+	26, // 8: kontaktparser.v1.Frame.eddystone_url:type_name -> kontaktparser.v1.EddystoneURL
+	27, // 9: kontaktparser.v1.Frame.eddystone_tlm:type_name -> kontaktparser.v1.EddystoneTLM
+	28, // 10: kontaktparser.v1.Frame.eddystone_etlm:type_name -> kontaktparser.v1.EddystoneETLM
+	29, // 11: kontaktparser.v1.Frame.eddystone_eid:type_name -> kontaktparser.v1.EddystoneEID
+	30, // 12: kontaktparser.v1.Frame.eddystone_unknown:type_name -> kontaktparser.v1.EddystoneUnknown
+	3,  // 13: kontaktparser.v1.KontaktScanResponse.shuffled_ibeacon:type_name -> kontaktparser.v1.IBeacon
+	9,  // 14: kontaktparser.v1.KontaktTelemetry.fields:type_name -> kontaktparser.v1.TelemetryValue
+	1,  // 15: kontaktparser.v1.TelemetryValue.pid:type_name -> kontaktparser.v1.TelemetryPID
+	10, // 16: kontaktparser.v1.TelemetryValue.system_health:type_name -> kontaktparser.v1.SystemHealthTelemetry
+	11, // 17: kontaktparser.v1.TelemetryValue.accelerometer:type_name -> kontaktparser.v1.AccelerometerTelemetry
+	12, // 18: kontaktparser.v1.TelemetryValue.sensors:type_name -> kontaktparser.v1.SensorsTelemetry
+	13, // 19: kontaktparser.v1.TelemetryValue.acceleration:type_name -> kontaktparser.v1.AccelerationTelemetry
+	14, // 20: kontaktparser.v1.TelemetryValue.movement:type_name -> kontaktparser.v1.MovementTelemetry
+	15, // 21: kontaktparser.v1.TelemetryValue.double_tap:type_name -> kontaktparser.v1.DoubleTapTelemetry
+	16, // 22: kontaktparser.v1.TelemetryValue.light_level:type_name -> kontaktparser.v1.LightLevelTelemetry
+	17, // 23: kontaktparser.v1.TelemetryValue.temperature_8bit:type_name -> kontaktparser.v1.Temperature8BitTelemetry
+	18, // 24: kontaktparser.v1.TelemetryValue.temperature_16bit:type_name -> kontaktparser.v1.Temperature16BitTelemetry
+	19, // 25: kontaktparser.v1.TelemetryValue.battery:type_name -> kontaktparser.v1.BatteryTelemetry
+	20, // 26: kontaktparser.v1.TelemetryValue.click:type_name -> kontaktparser.v1.ClickTelemetry
+	21, // 27: kontaktparser.v1.TelemetryValue.click_info:type_name -> kontaktparser.v1.ClickInfoTelemetry
+	22, // 28: kontaktparser.v1.TelemetryValue.utc_time:type_name -> kontaktparser.v1.UTCTimeTelemetry
+	23, // 29: kontaktparser.v1.TelemetryValue.humidity:type_name -> kontaktparser.v1.HumidityTelemetry
+	24, // 30: kontaktparser.v1.TelemetryValue.movement_info:type_name -> kontaktparser.v1.MovementInfoTelemetry
+	31, // [31:31] is the sub-list for method output_type
+	31, // [31:31] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
+}
+
+func init() { file_frames_proto_init() }
+func file_frames_proto_init() {
+	if File_frames_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_frames_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Frame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IBeacon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KontaktScanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KontaktPlain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KontaktShuffled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KontaktLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KontaktTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TelemetryValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemHealthTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccelerometerTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SensorsTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccelerationTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MovementTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleTapTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LightLevelTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Temperature8BitTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Temperature16BitTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatteryTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClickTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClickInfoTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UTCTimeTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HumidityTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MovementInfoTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EddystoneUID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EddystoneURL); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EddystoneTLM); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EddystoneETLM); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EddystoneEID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_frames_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EddystoneUnknown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_frames_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Frame_Ibeacon)(nil),
+		(*Frame_KontaktScanResponse)(nil),
+		(*Frame_KontaktPlain)(nil),
+		(*Frame_KontaktShuffled)(nil),
+		(*Frame_KontaktTelemetry)(nil),
+		(*Frame_KontaktLocation)(nil),
+		(*Frame_EddystoneUid)(nil),
+		(*Frame_EddystoneUrl)(nil),
+		(*Frame_EddystoneTlm)(nil),
+		(*Frame_EddystoneEtlm)(nil),
+		(*Frame_EddystoneEid)(nil),
+		(*Frame_EddystoneUnknown)(nil),
+	}
+	file_frames_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*TelemetryValue_SystemHealth)(nil),
+		(*TelemetryValue_Accelerometer)(nil),
+		(*TelemetryValue_Sensors)(nil),
+		(*TelemetryValue_Acceleration)(nil),
+		(*TelemetryValue_Movement)(nil),
+		(*TelemetryValue_DoubleTap)(nil),
+		(*TelemetryValue_LightLevel)(nil),
+		(*TelemetryValue_Temperature_8Bit)(nil),
+		(*TelemetryValue_Temperature_16Bit)(nil),
+		(*TelemetryValue_Battery)(nil),
+		(*TelemetryValue_Click)(nil),
+		(*TelemetryValue_ClickInfo)(nil),
+		(*TelemetryValue_UtcTime)(nil),
+		(*TelemetryValue_Humidity)(nil),
+		(*TelemetryValue_MovementInfo)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_frames_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   29,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_frames_proto_goTypes,
+		DependencyIndexes: file_frames_proto_depIdxs,
+		EnumInfos:         file_frames_proto_enumTypes,
+		MessageInfos:      file_frames_proto_msgTypes,
+	}.Build()
+	File_frames_proto = out.File
+	file_frames_proto_rawDesc = nil
+	file_frames_proto_goTypes = nil
+	file_frames_proto_depIdxs = nil
+}
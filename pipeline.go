@@ -0,0 +1,178 @@
+package kontaktparser
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// pipelineChunk is a number of records claimed at once by ParseAll worker, context is checked between chunks
+const pipelineChunk = 64
+
+// Pipeline parses scan records concurrently. Parsing errors don't stop the pipeline, they are stored
+// in ParsedRecord.Err of each record. Zero value parses with GOMAXPROCS workers, without ordering.
+type Pipeline struct {
+	// Workers limits number of goroutines parsing records, GOMAXPROCS is used when it's not positive
+	Workers int
+	// Ordered makes Stream emit results in order of input records. At most 2*Workers records are parsed
+	// ahead of the oldest one not emitted yet. ParseAll results are always ordered.
+	Ordered bool
+}
+
+func (p Pipeline) workers() int {
+	if p.Workers > 0 {
+		return p.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// ParseAll parses batch of records, result at given index belongs to record at the same index.
+// When ctx is cancelled, parsing stops and ctx.Err() is returned together with results parsed so far,
+// records not parsed yet have ctx.Err() stored in ParsedRecord.Err.
+func (p Pipeline) ParseAll(ctx context.Context, records []ScanRecord) ([]ParsedRecord, error) {
+	results := make([]ParsedRecord, len(records))
+	workers := p.workers()
+	if chunks := (len(records) + pipelineChunk - 1) / pipelineChunk; chunks < workers {
+		workers = chunks
+	}
+
+	next := int64(0)
+	wg := sync.WaitGroup{}
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			parser := Parser{}
+			for ctx.Err() == nil {
+				start := int(atomic.AddInt64(&next, pipelineChunk)) - pipelineChunk
+				if start >= len(records) {
+					return
+				}
+				end := start + pipelineChunk
+				if end > len(records) {
+					end = len(records)
+				}
+				for j := start; j < end; j++ {
+					results[j] = parseRecord(&parser, records[j])
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	err := ctx.Err()
+	if err != nil {
+		// claimed chunks are always parsed completely
+		for j := int(atomic.LoadInt64(&next)); j < len(records); j++ {
+			results[j] = ParsedRecord{ScanRecord: records[j], Err: err}
+		}
+	}
+	return results, err
+}
+
+// Stream parses records received from channel until it's closed or ctx is cancelled. Returned channel
+// is closed after all results are emitted or right after ctx is cancelled, check ctx.Err() to tell
+// these cases apart.
+func (p Pipeline) Stream(ctx context.Context, records <-chan ScanRecord) <-chan ParsedRecord {
+	if p.Ordered {
+		return p.streamOrdered(ctx, records)
+	}
+	workers := p.workers()
+	results := make(chan ParsedRecord, workers)
+	wg := sync.WaitGroup{}
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			parser := Parser{}
+			for {
+				select {
+				case record, ok := <-records:
+					if !ok {
+						return
+					}
+					select {
+					case results <- parseRecord(&parser, record):
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	return results
+}
+
+// pipelineJob is a record waiting for parsing, result is buffered so that worker never blocks on it
+type pipelineJob struct {
+	record ScanRecord
+	result chan ParsedRecord
+}
+
+// streamOrdered dispatches records to workers and queues their result channels in input order,
+// results are emitted by reading the queue
+func (p Pipeline) streamOrdered(ctx context.Context, records <-chan ScanRecord) <-chan ParsedRecord {
+	workers := p.workers()
+	jobs := make(chan pipelineJob, workers)
+	queue := make(chan chan ParsedRecord, workers)
+	results := make(chan ParsedRecord, workers)
+
+	go func() {
+		defer close(jobs)
+		defer close(queue)
+		for {
+			select {
+			case record, ok := <-records:
+				if !ok {
+					return
+				}
+				job := pipelineJob{record: record, result: make(chan ParsedRecord, 1)}
+				select {
+				case jobs <- job:
+				case <-ctx.Done():
+					return
+				}
+				select {
+				case queue <- job.result:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			parser := Parser{}
+			for job := range jobs {
+				job.result <- parseRecord(&parser, job.record)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(results)
+		for result := range queue {
+			select {
+			case parsed := <-result:
+				select {
+				case results <- parsed:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return results
+}
@@ -0,0 +1,178 @@
+package kontaktparser
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pipelineRecords builds n records cycling through fixtures of every frame type and an invalid packet,
+// ReceiverID holds record index
+func pipelineRecords(t testing.TB, n int) []ScanRecord {
+	packets := []ScanRecord{
+		{Data: decodeFixture(t, scanResponseFixture), ScanResponse: true},
+		{Data: decodeFixture(t, "FFFFFFFF")},
+	}
+	for _, dataHex := range frameFixtures {
+		packets = append(packets, ScanRecord{Data: decodeFixture(t, dataHex)})
+	}
+	records := make([]ScanRecord, n)
+	for i := range records {
+		records[i] = packets[i%len(packets)]
+		records[i].ReceiverID = strconv.Itoa(i)
+		records[i].Received = time.Unix(int64(i), 0)
+	}
+	return records
+}
+
+func parseSequential(records []ScanRecord) []ParsedRecord {
+	results := make([]ParsedRecord, len(records))
+	for i, record := range records {
+		results[i] = ParseRecord(record)
+	}
+	return results
+}
+
+func sendRecords(records []ScanRecord) <-chan ScanRecord {
+	input := make(chan ScanRecord)
+	go func() {
+		defer close(input)
+		for _, record := range records {
+			input <- record
+		}
+	}()
+	return input
+}
+
+func collect(results <-chan ParsedRecord) []ParsedRecord {
+	collected := make([]ParsedRecord, 0)
+	for result := range results {
+		collected = append(collected, result)
+	}
+	return collected
+}
+
+func TestPipelineParseAll(t *testing.T) {
+	records := pipelineRecords(t, 1000)
+	results, err := Pipeline{Workers: 4}.ParseAll(context.Background(), records)
+	assert.Nil(t, err)
+	assert.Equal(t, parseSequential(records), results)
+	assert.Equal(t, io.EOF, results[1].Err)
+}
+
+func TestPipelineParseAllEmpty(t *testing.T) {
+	results, err := Pipeline{}.ParseAll(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Empty(t, results)
+}
+
+func TestPipelineParseAllCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	records := pipelineRecords(t, 1000)
+	results, err := Pipeline{Workers: 4}.ParseAll(ctx, records)
+	assert.Equal(t, context.Canceled, err)
+	assert.Len(t, results, len(records))
+	for i, result := range results {
+		assert.Equal(t, records[i], result.ScanRecord)
+		assert.Equal(t, context.Canceled, result.Err)
+		assert.Equal(t, Unknown, result.DetectedType)
+	}
+}
+
+func TestPipelineParseAllCancelledWhileParsing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	records := make([]ScanRecord, 0)
+	for len(records) < 20000 {
+		records = append(records, pipelineRecords(t, 1000)...)
+	}
+	go cancel()
+	results, err := Pipeline{Workers: 4}.ParseAll(ctx, records)
+	if err == nil {
+		t.Skip("parsing finished before cancellation")
+	}
+	for i, result := range results {
+		assert.Equal(t, records[i], result.ScanRecord)
+		// every record is either parsed or marked as cancelled, never left zero
+		if result.Err == context.Canceled {
+			continue
+		}
+		assert.Equal(t, parseSequential(records[i : i+1])[0], result)
+	}
+}
+
+func TestPipelineStreamOrdered(t *testing.T) {
+	records := pipelineRecords(t, 1000)
+	results := collect(Pipeline{Workers: 4, Ordered: true}.Stream(context.Background(), sendRecords(records)))
+	assert.Equal(t, parseSequential(records), results)
+}
+
+func TestPipelineStreamUnordered(t *testing.T) {
+	records := pipelineRecords(t, 1000)
+	results := collect(Pipeline{Workers: 4}.Stream(context.Background(), sendRecords(records)))
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Received.Before(results[j].Received)
+	})
+	assert.Equal(t, parseSequential(records), results)
+}
+
+func TestPipelineStreamCancelled(t *testing.T) {
+	for _, ordered := range []bool{false, true} {
+		ctx, cancel := context.WithCancel(context.Background())
+		input := make(chan ScanRecord)
+		results := Pipeline{Workers: 2, Ordered: ordered}.Stream(ctx, input)
+
+		record := pipelineRecords(t, 3)[2]
+		input <- record
+		assert.Equal(t, ParseRecord(record), <-results)
+
+		// input is never closed, results are closed by cancellation
+		cancel()
+		for range results {
+		}
+		assert.Equal(t, context.Canceled, ctx.Err())
+	}
+}
+
+const benchmarkBatchSize = 10000
+
+func BenchmarkParseSequential(b *testing.B) {
+	records := pipelineRecords(b, benchmarkBatchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parseSequential(records)
+	}
+}
+
+func BenchmarkPipelineParseAll(b *testing.B) {
+	records := pipelineRecords(b, benchmarkBatchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := (Pipeline{}).ParseAll(context.Background(), records); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkPipelineStream(b *testing.B, ordered bool) {
+	records := pipelineRecords(b, benchmarkBatchSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		results := Pipeline{Ordered: ordered}.Stream(context.Background(), sendRecords(records))
+		for range results {
+		}
+	}
+}
+
+func BenchmarkPipelineStream(b *testing.B) {
+	benchmarkPipelineStream(b, false)
+}
+
+func BenchmarkPipelineStreamOrdered(b *testing.B) {
+	benchmarkPipelineStream(b, true)
+}
@@ -0,0 +1,216 @@
+package kontaktparser
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrNotEnoughMeasurements = errors.New("not enough measurements to compute position")
+	ErrDegenerateGeometry    = errors.New("receivers geometry doesn't allow to compute position")
+	ErrInvalidDimensions     = errors.New("position can be computed only in 2 or 3 dimensions")
+)
+
+// Point is a position in meters. Z is ignored by 2-D positioning.
+type Point struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+func (p Point) distance(other Point, dimensions int) float64 {
+	dx, dy, dz := p.X-other.X, p.Y-other.Y, p.Z-other.Z
+	if dimensions == 2 {
+		dz = 0
+	}
+	return math.Sqrt(dx*dx + dy*dy + dz*dz)
+}
+
+func (p Point) coordinate(i int) float64 {
+	switch i {
+	case 0:
+		return p.X
+	case 1:
+		return p.Y
+	}
+	return p.Z
+}
+
+// RangeMeasurement is a distance between beacon and receiver placed at known position
+type RangeMeasurement struct {
+	ReceiverID string
+	Receiver   Point
+	Distance   float64
+}
+
+// PositionEstimate is a computed beacon position
+type PositionEstimate struct {
+	Position Point
+	// Error is a weighted RMS difference between measured distances and distances to computed position
+	Error float64
+	// Trilaterated is false when position was computed with weighted centroid fallback
+	Trilaterated bool
+}
+
+// RangesFromRSSI converts smoothed RSSI measured by receivers into distances with given model.
+// Receivers which positions are unknown or RSSI couldn't be converted are skipped.
+func RangesFromRSSI(receivers map[string]Point, rssi map[string]float64, referenceRSSI int8, model DistanceModel) []RangeMeasurement {
+	ranges := make([]RangeMeasurement, 0, len(rssi))
+	for id, value := range rssi {
+		position, ok := receivers[id]
+		if !ok {
+			continue
+		}
+		distance := model.Distance(value, referenceRSSI)
+		if distance < 0 {
+			continue
+		}
+		ranges = append(ranges, RangeMeasurement{ReceiverID: id, Receiver: position, Distance: distance})
+	}
+	return ranges
+}
+
+// rangeWeight makes closer receivers, which distance error is smaller, more important
+func rangeWeight(distance float64) float64 {
+	return 1 / math.Max(distance*distance, 0.01)
+}
+
+// Trilaterate computes position with weighted least squares from at least dimensions+1 measurements.
+// Dimensions have to be 2 or 3, ErrInvalidDimensions is returned otherwise.
+func Trilaterate(ranges []RangeMeasurement, dimensions int) (PositionEstimate, error) {
+	if dimensions != 2 && dimensions != 3 {
+		return PositionEstimate{}, ErrInvalidDimensions
+	}
+	if len(ranges) < dimensions+1 {
+		return PositionEstimate{}, ErrNotEnoughMeasurements
+	}
+	// equations are linearized by subtracting the one of the closest receiver
+	reference := 0
+	for i := range ranges {
+		if ranges[i].Distance < ranges[reference].Distance {
+			reference = i
+		}
+	}
+	r := ranges[reference]
+
+	// normal equations (A^T W A) x = A^T W b
+	ata := make([][]float64, dimensions)
+	for i := range ata {
+		ata[i] = make([]float64, dimensions+1)
+	}
+	for i, m := range ranges {
+		if i == reference {
+			continue
+		}
+		row := make([]float64, dimensions)
+		b := r.Distance*r.Distance - m.Distance*m.Distance
+		for j := 0; j < dimensions; j++ {
+			mj, rj := m.Receiver.coordinate(j), r.Receiver.coordinate(j)
+			row[j] = 2 * (mj - rj)
+			b += mj*mj - rj*rj
+		}
+		weight := rangeWeight(m.Distance)
+		for j := 0; j < dimensions; j++ {
+			for k := 0; k < dimensions; k++ {
+				ata[j][k] += weight * row[j] * row[k]
+			}
+			ata[j][dimensions] += weight * row[j] * b
+		}
+	}
+	solution, ok := solveLinear(ata)
+	if !ok {
+		return PositionEstimate{}, ErrDegenerateGeometry
+	}
+	position := Point{X: solution[0], Y: solution[1]}
+	if dimensions == 3 {
+		position.Z = solution[2]
+	}
+	return PositionEstimate{
+		Position:     position,
+		Error:        positionError(position, ranges, dimensions),
+		Trilaterated: true,
+	}, nil
+}
+
+// WeightedCentroid computes position as an average of receiver positions weighted by inverse square
+// of distance. It works with any number of measurements but is biased towards the receivers.
+func WeightedCentroid(ranges []RangeMeasurement, dimensions int) (PositionEstimate, error) {
+	if dimensions != 2 && dimensions != 3 {
+		return PositionEstimate{}, ErrInvalidDimensions
+	}
+	if len(ranges) == 0 {
+		return PositionEstimate{}, ErrNotEnoughMeasurements
+	}
+	position := Point{}
+	total := 0.0
+	for _, m := range ranges {
+		weight := rangeWeight(m.Distance)
+		position.X += weight * m.Receiver.X
+		position.Y += weight * m.Receiver.Y
+		position.Z += weight * m.Receiver.Z
+		total += weight
+	}
+	position.X /= total
+	position.Y /= total
+	position.Z /= total
+	if dimensions == 2 {
+		position.Z = 0
+	}
+	return PositionEstimate{
+		Position: position,
+		Error:    positionError(position, ranges, dimensions),
+	}, nil
+}
+
+// Locate computes position with trilateration, falling back to weighted centroid when there is not enough
+// measurements or receivers geometry is degenerate (e.g. all receivers on a single line)
+func Locate(ranges []RangeMeasurement, dimensions int) (PositionEstimate, error) {
+	estimate, err := Trilaterate(ranges, dimensions)
+	if err == ErrNotEnoughMeasurements || err == ErrDegenerateGeometry {
+		return WeightedCentroid(ranges, dimensions)
+	}
+	return estimate, err
+}
+
+func positionError(position Point, ranges []RangeMeasurement, dimensions int) float64 {
+	sum, total := 0.0, 0.0
+	for _, m := range ranges {
+		residual := position.distance(m.Receiver, dimensions) - m.Distance
+		weight := rangeWeight(m.Distance)
+		sum += weight * residual * residual
+		total += weight
+	}
+	return math.Sqrt(sum / total)
+}
+
+// solveLinear solves system given as augmented matrix with Gaussian elimination
+func solveLinear(m [][]float64) ([]float64, bool) {
+	n := len(m)
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(m[row][col]) > math.Abs(m[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(m[pivot][col]) < 1e-9 {
+			return nil, false
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+		for row := col + 1; row < n; row++ {
+			factor := m[row][col] / m[col][col]
+			for k := col; k <= n; k++ {
+				m[row][k] -= factor * m[col][k]
+			}
+		}
+	}
+	solution := make([]float64, n)
+	for row := n - 1; row >= 0; row-- {
+		value := m[row][n]
+		for k := row + 1; k < n; k++ {
+			value -= m[row][k] * solution[k]
+		}
+		solution[row] = value / m[row][row]
+	}
+	return solution, true
+}
@@ -0,0 +1,119 @@
+package kontaktparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rangesTo(position Point, dimensions int, receivers ...Point) []RangeMeasurement {
+	ranges := make([]RangeMeasurement, len(receivers))
+	for i, receiver := range receivers {
+		ranges[i] = RangeMeasurement{Receiver: receiver, Distance: position.distance(receiver, dimensions)}
+	}
+	return ranges
+}
+
+func assertPoint(t *testing.T, expected Point, actual Point) {
+	assert.InDelta(t, expected.X, actual.X, 0.001)
+	assert.InDelta(t, expected.Y, actual.Y, 0.001)
+	assert.InDelta(t, expected.Z, actual.Z, 0.001)
+}
+
+func TestTrilaterate2D(t *testing.T) {
+	beacon := Point{X: 3, Y: 4}
+	ranges := rangesTo(beacon, 2, Point{0, 0, 0}, Point{10, 0, 0}, Point{0, 10, 0}, Point{10, 10, 0})
+
+	estimate, err := Trilaterate(ranges, 2)
+	assert.Nil(t, err)
+	assert.True(t, estimate.Trilaterated)
+	assertPoint(t, beacon, estimate.Position)
+	assert.InDelta(t, 0, estimate.Error, 0.001)
+}
+
+func TestTrilaterate3D(t *testing.T) {
+	beacon := Point{X: 3, Y: 4, Z: 1}
+	ranges := rangesTo(beacon, 3, Point{0, 0, 3}, Point{10, 0, 3}, Point{0, 10, 3}, Point{10, 10, 0})
+
+	estimate, err := Trilaterate(ranges, 3)
+	assert.Nil(t, err)
+	assertPoint(t, beacon, estimate.Position)
+}
+
+func TestTrilaterateNoisyDistances(t *testing.T) {
+	ranges := rangesTo(Point{X: 5, Y: 5}, 2, Point{0, 0, 0}, Point{10, 0, 0}, Point{0, 10, 0}, Point{10, 10, 0})
+	ranges[0].Distance += 1
+
+	estimate, err := Trilaterate(ranges, 2)
+	assert.Nil(t, err)
+	assert.InDelta(t, 5, estimate.Position.X, 1)
+	assert.InDelta(t, 5, estimate.Position.Y, 1)
+	assert.True(t, estimate.Error > 0)
+}
+
+func TestTrilaterateErrors(t *testing.T) {
+	_, err := Trilaterate(rangesTo(Point{}, 2, Point{0, 0, 0}, Point{10, 0, 0}), 2)
+	assert.Equal(t, ErrNotEnoughMeasurements, err)
+
+	_, err = Trilaterate(rangesTo(Point{X: 3, Y: 4}, 2, Point{0, 0, 0}, Point{5, 0, 0}, Point{10, 0, 0}), 2)
+	assert.Equal(t, ErrDegenerateGeometry, err)
+}
+
+func TestInvalidDimensions(t *testing.T) {
+	ranges := rangesTo(Point{X: 2, Y: 2}, 2, Point{0, 0, 0}, Point{10, 0, 0}, Point{0, 10, 0})
+	for _, dimensions := range []int{-1, 0, 1, 4} {
+		_, err := Trilaterate(ranges[:2], dimensions)
+		assert.Equal(t, ErrInvalidDimensions, err)
+		_, err = Trilaterate(ranges, dimensions)
+		assert.Equal(t, ErrInvalidDimensions, err)
+		_, err = WeightedCentroid(ranges, dimensions)
+		assert.Equal(t, ErrInvalidDimensions, err)
+		_, err = Locate(ranges, dimensions)
+		assert.Equal(t, ErrInvalidDimensions, err)
+	}
+}
+
+func TestWeightedCentroid(t *testing.T) {
+	ranges := []RangeMeasurement{
+		{Receiver: Point{0, 0, 2}, Distance: 1},
+		{Receiver: Point{10, 0, 2}, Distance: 1},
+	}
+	estimate, err := WeightedCentroid(ranges, 2)
+	assert.Nil(t, err)
+	assert.False(t, estimate.Trilaterated)
+	assertPoint(t, Point{X: 5}, estimate.Position)
+	assert.InDelta(t, 4, estimate.Error, 0.001)
+
+	_, err = WeightedCentroid(nil, 2)
+	assert.Equal(t, ErrNotEnoughMeasurements, err)
+}
+
+func TestLocateFallsBackToCentroid(t *testing.T) {
+	ranges := rangesTo(Point{X: 2}, 2, Point{0, 0, 0}, Point{10, 0, 0})
+	estimate, err := Locate(ranges, 2)
+	assert.Nil(t, err)
+	assert.False(t, estimate.Trilaterated)
+
+	ranges = rangesTo(Point{X: 2, Y: 2}, 2, Point{0, 0, 0}, Point{10, 0, 0}, Point{0, 10, 0})
+	estimate, err = Locate(ranges, 2)
+	assert.Nil(t, err)
+	assert.True(t, estimate.Trilaterated)
+	assertPoint(t, Point{X: 2, Y: 2}, estimate.Position)
+}
+
+func TestRangesFromRSSI(t *testing.T) {
+	receivers := map[string]Point{"a": {0, 0, 0}, "b": {10, 0, 0}}
+	rssi := map[string]float64{"a": -59, "b": -79, "c": -60}
+
+	ranges := RangesFromRSSI(receivers, rssi, -59, DefaultPathLossModel)
+	assert.Equal(t, 2, len(ranges))
+	for _, r := range ranges {
+		switch r.ReceiverID {
+		case "a":
+			assert.InDelta(t, 1, r.Distance, 0.001)
+		case "b":
+			assert.InDelta(t, 10, r.Distance, 0.001)
+			assert.Equal(t, receivers["b"], r.Receiver)
+		}
+	}
+}
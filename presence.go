@@ -0,0 +1,257 @@
+package kontaktparser
+
+import (
+	"sync"
+	"time"
+)
+
+// ZoneEventType is a type of ZoneEvent
+type ZoneEventType int
+
+const (
+	// ZoneEnter - beacon was assigned to the zone
+	ZoneEnter ZoneEventType = iota
+	// ZoneExit - beacon left the zone
+	ZoneExit
+)
+
+func (t ZoneEventType) String() string {
+	if t == ZoneEnter {
+		return "enter"
+	}
+	return "exit"
+}
+
+// ZoneEvent is emitted by PresenceEngine when beacon enters or leaves a zone
+type ZoneEvent struct {
+	Type     ZoneEventType
+	BeaconID string
+	Zone     string
+	Time     time.Time
+}
+
+// PresenceConfig configures PresenceEngine
+type PresenceConfig struct {
+	// ReceiverZones maps receiver ID to zone, receivers not present in the map are zones on their own
+	ReceiverZones map[string]string
+	// MinRSSI is the weakest filtered RSSI allowing beacon to be assigned to a zone
+	MinRSSI float64
+	// Hysteresis is a number of dB by which other zone has to be stronger than the current one
+	Hysteresis float64
+	// MinDwell is a time for which other zone has to be the strongest one before beacon is moved there
+	MinDwell time.Duration
+	// Timeout is a time after which receiver which stopped hearing the beacon is not taken into account
+	Timeout time.Duration
+	// NewFilter creates RSSI filter for each beacon and receiver pair, raw RSSI is used when nil
+	NewFilter func() RSSIFilter
+}
+
+type receiverReading struct {
+	filter   RSSIFilter
+	rssi     float64
+	received time.Time
+}
+
+type beaconPresence struct {
+	zone           string
+	candidate      string
+	candidateSince time.Time
+	receivers      map[string]*receiverReading
+}
+
+// PresenceEngine assigns each beacon to the zone which receivers hear it with the strongest filtered RSSI.
+// It is safe for concurrent use.
+type PresenceEngine struct {
+	// OnEvent is called for each emitted event. It has to be set before PresenceEngine is used.
+	OnEvent func(ZoneEvent)
+
+	config  PresenceConfig
+	mutex   sync.Mutex
+	beacons map[string]*beaconPresence
+	tracker *Tracker
+}
+
+// NewPresenceEngine creates PresenceEngine with given configuration
+func NewPresenceEngine(config PresenceConfig) *PresenceEngine {
+	return &PresenceEngine{
+		config:  config,
+		beacons: make(map[string]*beaconPresence),
+		tracker: NewTracker(config.Timeout),
+	}
+}
+
+// Ingest updates presence with frame (Parser.Parsed) heard by given receiver. Beacon is identified the same
+// way as by Tracker, frames without identity are linked by MAC address to the beacon which sent them.
+// Frames with neither identity nor MAC address are ignored.
+func (e *PresenceEngine) Ingest(receiverID string, mac string, rssi int8, received time.Time, parsed interface{}) []ZoneEvent {
+	e.mutex.Lock()
+	previous, _ := e.tracker.DeviceByMAC(mac)
+	device := e.tracker.Ingest(mac, rssi, received, parsed)
+	events := make([]ZoneEvent, 0)
+	if _, ok := e.tracker.Device(previous.ID); previous.ID != "" && !ok {
+		// tracker replaced identity known so far, e.g. "mac:..." with Kontakt.io Unique ID
+		events = e.relink(previous.ID, device.ID, received)
+	}
+	e.mutex.Unlock()
+
+	e.emit(events)
+	if device.ID == "" {
+		return events
+	}
+	return append(events, e.Update(device.ID, receiverID, rssi, received)...)
+}
+
+// relink moves receiver readings of a beacon to its new identity. Beacon leaves its zone under the old
+// identity and enters it again under the new one, when it's evaluated.
+func (e *PresenceEngine) relink(oldID string, newID string, now time.Time) []ZoneEvent {
+	old, ok := e.beacons[oldID]
+	if !ok {
+		return nil
+	}
+	delete(e.beacons, oldID)
+	events := make([]ZoneEvent, 0)
+	if old.zone != "" {
+		events = append(events, ZoneEvent{Type: ZoneExit, BeaconID: oldID, Zone: old.zone, Time: now})
+	}
+	beacon, ok := e.beacons[newID]
+	if !ok {
+		e.beacons[newID] = &beaconPresence{receivers: old.receivers}
+		return events
+	}
+	for receiverID, reading := range old.receivers {
+		if _, ok := beacon.receivers[receiverID]; !ok {
+			beacon.receivers[receiverID] = reading
+		}
+	}
+	return events
+}
+
+// Update updates presence of a beacon with RSSI measured by given receiver
+func (e *PresenceEngine) Update(beaconID string, receiverID string, rssi int8, received time.Time) []ZoneEvent {
+	e.mutex.Lock()
+	beacon, ok := e.beacons[beaconID]
+	if !ok {
+		beacon = &beaconPresence{receivers: make(map[string]*receiverReading)}
+		e.beacons[beaconID] = beacon
+	}
+	reading, ok := beacon.receivers[receiverID]
+	if !ok {
+		reading = &receiverReading{}
+		if e.config.NewFilter != nil {
+			reading.filter = e.config.NewFilter()
+		}
+		beacon.receivers[receiverID] = reading
+	}
+	reading.rssi = float64(rssi)
+	if reading.filter != nil {
+		reading.rssi = reading.filter.Update(rssi)
+	}
+	reading.received = received
+
+	events := e.evaluate(beaconID, beacon, received)
+	e.mutex.Unlock()
+
+	e.emit(events)
+	return events
+}
+
+// Expire checks beacons which stopped being heard. Beacon which isn't heard by any receiver of its zone
+// for longer than Timeout leaves the zone. It should be called periodically.
+func (e *PresenceEngine) Expire(now time.Time) []ZoneEvent {
+	events := make([]ZoneEvent, 0)
+	e.mutex.Lock()
+	for id, beacon := range e.beacons {
+		for receiverID, reading := range beacon.receivers {
+			if now.Sub(reading.received) > e.config.Timeout {
+				delete(beacon.receivers, receiverID)
+			}
+		}
+		events = append(events, e.evaluate(id, beacon, now)...)
+		if len(beacon.receivers) == 0 {
+			delete(e.beacons, id)
+		}
+	}
+	e.tracker.Expire(now)
+	e.mutex.Unlock()
+
+	e.emit(events)
+	return events
+}
+
+// Zone returns zone beacon is currently assigned to
+func (e *PresenceEngine) Zone(beaconID string) (string, bool) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if beacon, ok := e.beacons[beaconID]; ok && beacon.zone != "" {
+		return beacon.zone, true
+	}
+	return "", false
+}
+
+func (e *PresenceEngine) emit(events []ZoneEvent) {
+	if e.OnEvent == nil {
+		return
+	}
+	for _, event := range events {
+		e.OnEvent(event)
+	}
+}
+
+func (e *PresenceEngine) zoneOf(receiverID string) string {
+	if zone, ok := e.config.ReceiverZones[receiverID]; ok {
+		return zone
+	}
+	return receiverID
+}
+
+// zoneStrengths returns the strongest filtered RSSI of each zone, skipping stale and too weak readings
+func (e *PresenceEngine) zoneStrengths(beacon *beaconPresence, now time.Time) map[string]float64 {
+	strengths := make(map[string]float64)
+	for receiverID, reading := range beacon.receivers {
+		if now.Sub(reading.received) > e.config.Timeout || reading.rssi < e.config.MinRSSI {
+			continue
+		}
+		zone := e.zoneOf(receiverID)
+		if current, ok := strengths[zone]; !ok || reading.rssi > current {
+			strengths[zone] = reading.rssi
+		}
+	}
+	return strengths
+}
+
+func (e *PresenceEngine) evaluate(beaconID string, beacon *beaconPresence, now time.Time) []ZoneEvent {
+	strengths := e.zoneStrengths(beacon, now)
+	events := make([]ZoneEvent, 0)
+
+	current, inZone := strengths[beacon.zone]
+	if beacon.zone != "" && !inZone {
+		events = append(events, ZoneEvent{Type: ZoneExit, BeaconID: beaconID, Zone: beacon.zone, Time: now})
+		beacon.zone = ""
+	}
+
+	best, bestStrength := "", 0.0
+	for zone, strength := range strengths {
+		if best == "" || strength > bestStrength || (strength == bestStrength && zone < best) {
+			best, bestStrength = zone, strength
+		}
+	}
+	if best == "" || best == beacon.zone || (beacon.zone != "" && bestStrength < current+e.config.Hysteresis) {
+		beacon.candidate = ""
+		return events
+	}
+	if beacon.candidate != best {
+		beacon.candidate = best
+		beacon.candidateSince = now
+	}
+	if now.Sub(beacon.candidateSince) < e.config.MinDwell {
+		return events
+	}
+
+	if beacon.zone != "" {
+		events = append(events, ZoneEvent{Type: ZoneExit, BeaconID: beaconID, Zone: beacon.zone, Time: now})
+	}
+	events = append(events, ZoneEvent{Type: ZoneEnter, BeaconID: beaconID, Zone: best, Time: now})
+	beacon.zone = best
+	beacon.candidate = ""
+	return events
+}
@@ -0,0 +1,147 @@
+package kontaktparser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var presenceStart = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func presenceConfig() PresenceConfig {
+	return PresenceConfig{
+		ReceiverZones: map[string]string{"gw-1": "room-1", "gw-2": "room-1", "gw-3": "room-2"},
+		MinRSSI:       -90,
+		Hysteresis:    5,
+		MinDwell:      2 * time.Second,
+		Timeout:       10 * time.Second,
+	}
+}
+
+func TestPresenceEnterAfterDwell(t *testing.T) {
+	engine := NewPresenceEngine(presenceConfig())
+	received := make([]ZoneEvent, 0)
+	engine.OnEvent = func(event ZoneEvent) {
+		received = append(received, event)
+	}
+
+	assert.Equal(t, 0, len(engine.Update("b", "gw-1", -60, presenceStart)))
+	assert.Equal(t, 0, len(engine.Update("b", "gw-3", -70, presenceStart.Add(time.Second))))
+	events := engine.Update("b", "gw-2", -65, presenceStart.Add(2*time.Second))
+
+	expected := []ZoneEvent{{Type: ZoneEnter, BeaconID: "b", Zone: "room-1", Time: presenceStart.Add(2 * time.Second)}}
+	assert.Equal(t, expected, events)
+	assert.Equal(t, expected, received)
+	zone, ok := engine.Zone("b")
+	assert.True(t, ok)
+	assert.Equal(t, "room-1", zone)
+}
+
+func TestPresenceHysteresis(t *testing.T) {
+	config := presenceConfig()
+	config.MinDwell = 0
+	engine := NewPresenceEngine(config)
+
+	engine.Update("b", "gw-1", -70, presenceStart)
+	// room-2 is stronger, but not by hysteresis margin
+	assert.Equal(t, 0, len(engine.Update("b", "gw-3", -67, presenceStart)))
+	events := engine.Update("b", "gw-3", -64, presenceStart.Add(time.Second))
+
+	assert.Equal(t, []ZoneEvent{
+		{Type: ZoneExit, BeaconID: "b", Zone: "room-1", Time: presenceStart.Add(time.Second)},
+		{Type: ZoneEnter, BeaconID: "b", Zone: "room-2", Time: presenceStart.Add(time.Second)},
+	}, events)
+}
+
+func TestPresenceDwellResetsWhenCandidateChanges(t *testing.T) {
+	engine := NewPresenceEngine(presenceConfig())
+	engine.Update("b", "gw-1", -60, presenceStart)
+	engine.Update("b", "gw-1", -60, presenceStart.Add(2*time.Second))
+
+	engine.Update("b", "gw-3", -50, presenceStart.Add(3*time.Second))
+	engine.Update("b", "gw-3", -70, presenceStart.Add(4*time.Second))
+	engine.Update("b", "gw-3", -50, presenceStart.Add(5*time.Second))
+	assert.Equal(t, 0, len(engine.Update("b", "gw-3", -50, presenceStart.Add(6*time.Second))))
+	events := engine.Update("b", "gw-3", -50, presenceStart.Add(7*time.Second))
+	assert.Equal(t, 2, len(events))
+	assert.Equal(t, "room-2", events[1].Zone)
+}
+
+func TestPresenceIgnoresWeakSignal(t *testing.T) {
+	config := presenceConfig()
+	config.MinDwell = 0
+	engine := NewPresenceEngine(config)
+
+	assert.Equal(t, 0, len(engine.Update("b", "gw-1", -95, presenceStart)))
+	_, ok := engine.Zone("b")
+	assert.False(t, ok)
+}
+
+func TestPresenceExpire(t *testing.T) {
+	config := presenceConfig()
+	config.MinDwell = 0
+	engine := NewPresenceEngine(config)
+	engine.Update("b", "gw-1", -60, presenceStart)
+
+	assert.Equal(t, 0, len(engine.Expire(presenceStart.Add(5*time.Second))))
+	events := engine.Expire(presenceStart.Add(11 * time.Second))
+	assert.Equal(t, []ZoneEvent{
+		{Type: ZoneExit, BeaconID: "b", Zone: "room-1", Time: presenceStart.Add(11 * time.Second)},
+	}, events)
+	_, ok := engine.Zone("b")
+	assert.False(t, ok)
+}
+
+func TestPresenceIngestUsesFrameIdentity(t *testing.T) {
+	config := presenceConfig()
+	config.MinDwell = 0
+	config.NewFilter = func() RSSIFilter {
+		return NewMovingAverageFilter(2)
+	}
+	engine := NewPresenceEngine(config)
+
+	events := engine.Ingest("gw-3", "AA:BB:CC:DD:EE:FF", -60, presenceStart, &KontaktPlainAdvertisement{UniqueID: "abcd"})
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, "kontakt:abcd", events[0].BeaconID)
+
+	// telemetry is linked to the beacon by MAC address
+	events = engine.Ingest("gw-1", "AA:BB:CC:DD:EE:FF", -50, presenceStart.Add(time.Second), &KontaktTelemetryAdvertisement{})
+	assert.Equal(t, []ZoneEvent{
+		{Type: ZoneExit, BeaconID: "kontakt:abcd", Zone: "room-2", Time: presenceStart.Add(time.Second)},
+		{Type: ZoneEnter, BeaconID: "kontakt:abcd", Zone: "room-1", Time: presenceStart.Add(time.Second)},
+	}, events)
+	_, ok := engine.Zone("mac:AA:BB:CC:DD:EE:FF")
+	assert.False(t, ok)
+}
+
+func TestPresenceIngestPromotesIdentity(t *testing.T) {
+	config := presenceConfig()
+	config.MinDwell = 0
+	engine := NewPresenceEngine(config)
+
+	events := engine.Ingest("gw-3", "AA:BB:CC:DD:EE:FF", -60, presenceStart, &KontaktTelemetryAdvertisement{})
+	assert.Equal(t, []ZoneEvent{{Type: ZoneEnter, BeaconID: "mac:AA:BB:CC:DD:EE:FF", Zone: "room-2", Time: presenceStart}}, events)
+
+	events = engine.Ingest("gw-3", "AA:BB:CC:DD:EE:FF", -60, presenceStart, &KontaktPlainAdvertisement{UniqueID: "abcd"})
+	assert.Equal(t, []ZoneEvent{
+		{Type: ZoneExit, BeaconID: "mac:AA:BB:CC:DD:EE:FF", Zone: "room-2", Time: presenceStart},
+		{Type: ZoneEnter, BeaconID: "kontakt:abcd", Zone: "room-2", Time: presenceStart},
+	}, events)
+
+	events = engine.Ingest("gw-3", "AA:BB:CC:DD:EE:FF", -60, presenceStart, &KontaktTelemetryAdvertisement{})
+	assert.Empty(t, events)
+	_, ok := engine.Zone("mac:AA:BB:CC:DD:EE:FF")
+	assert.False(t, ok)
+}
+
+func TestPresenceIngestWithoutMAC(t *testing.T) {
+	config := presenceConfig()
+	config.MinDwell = 0
+	engine := NewPresenceEngine(config)
+
+	assert.Empty(t, engine.Ingest("gw-3", "", -60, presenceStart, &KontaktTelemetryAdvertisement{}))
+	events := engine.Ingest("gw-3", "", -60, presenceStart, &KontaktPlainAdvertisement{UniqueID: "abcd"})
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, "kontakt:abcd", events[0].BeaconID)
+}
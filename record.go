@@ -0,0 +1,138 @@
+package kontaktparser
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+var ErrInvalidScanLineData = errors.New("scan line data is not a valid hex")
+
+// ScanRecord is a single raw advertisement or scan response heard by a receiver
+type ScanRecord struct {
+	ReceiverID string
+	MAC        string
+	RSSI       int8
+	// Channel is an advertising channel packet was received on, if receiver reports it
+	Channel  AdvertisingChannel
+	Received time.Time
+	Data     []byte
+	// ScanResponse marks Data as scan response instead of advertisement
+	ScanResponse bool
+}
+
+// ScanLine is a ScanRecord encoded as JSON, the format of scanners which don't use any gateway format
+// and of JSON lines scan logs. Data is hex encoded.
+type ScanLine struct {
+	Receiver     string    `json:"receiver,omitempty"`
+	MAC          string    `json:"mac"`
+	RSSI         int8      `json:"rssi"`
+	Timestamp    time.Time `json:"timestamp"`
+	Data         string    `json:"data"`
+	ScanResponse bool      `json:"scanResponse,omitempty"`
+}
+
+// NewScanLine encodes record as ScanLine. Channel isn't stored.
+func NewScanLine(record ScanRecord) ScanLine {
+	return ScanLine{
+		Receiver:     record.ReceiverID,
+		MAC:          record.MAC,
+		RSSI:         record.RSSI,
+		Timestamp:    record.Received,
+		Data:         strings.ToUpper(hex.EncodeToString(record.Data)),
+		ScanResponse: record.ScanResponse,
+	}
+}
+
+// Record decodes scan line, ErrInvalidScanLineData is returned when data is empty or not a valid hex.
+// MAC address is converted to upper case.
+func (l ScanLine) Record() (ScanRecord, error) {
+	data, err := hex.DecodeString(strings.TrimSpace(l.Data))
+	if err != nil || len(data) == 0 {
+		return ScanRecord{}, ErrInvalidScanLineData
+	}
+	return ScanRecord{
+		ReceiverID:   l.Receiver,
+		MAC:          strings.ToUpper(l.MAC),
+		RSSI:         l.RSSI,
+		Received:     l.Timestamp,
+		Data:         data,
+		ScanResponse: l.ScanResponse,
+	}, nil
+}
+
+// DecodeScanLines decodes single JSON ScanLine or an array of them. JSON errors are returned as they are,
+// ErrInvalidScanLineData when any line carries invalid data.
+func DecodeScanLines(payload []byte) ([]ScanRecord, error) {
+	lines := make([]ScanLine, 0)
+	payload = []byte(strings.TrimSpace(string(payload)))
+	if len(payload) > 0 && payload[0] == '[' {
+		if err := json.Unmarshal(payload, &lines); err != nil {
+			return nil, err
+		}
+	} else {
+		line := ScanLine{}
+		if err := json.Unmarshal(payload, &line); err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+
+	records := make([]ScanRecord, 0, len(lines))
+	for _, line := range lines {
+		record, err := line.Record()
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// Parse parses Data as scan response or advertisement, depending on ScanResponse flag
+func (r *ScanRecord) Parse() (Parser, error) {
+	parser := New(r.Data)
+	var err error
+	if r.ScanResponse {
+		err = parser.ParseScanResponse()
+	} else {
+		err = parser.ParseAdvertisement()
+	}
+	return parser, err
+}
+
+// ParsedRecord is a ScanRecord together with its parsing result
+type ParsedRecord struct {
+	ScanRecord
+	DetectedType DetectedType
+	Flags        byte
+	Parsed       interface{}
+	Err          error
+}
+
+// ParseRecord parses record data, parsing error is stored in the result
+func ParseRecord(record ScanRecord) ParsedRecord {
+	parser := Parser{}
+	return parseRecord(&parser, record)
+}
+
+// parseRecord parses record data with reused parser
+func parseRecord(parser *Parser, record ScanRecord) ParsedRecord {
+	var err error
+	if record.ScanResponse {
+		parser.ResetScanResponse(record.Data)
+		err = parser.ParseScanResponse()
+	} else {
+		parser.Reset(record.Data)
+		err = parser.ParseAdvertisement()
+	}
+	return ParsedRecord{
+		ScanRecord:   record,
+		DetectedType: parser.DetectedType,
+		Flags:        parser.Flags,
+		Parsed:       parser.Parsed,
+		Err:          err,
+	}
+}
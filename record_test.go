@@ -0,0 +1,59 @@
+package kontaktparser
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRecord(t *testing.T) {
+	data, _ := hex.DecodeString("0201060F166AFE0206010F6404616263646566")
+	parsed := ParseRecord(ScanRecord{ReceiverID: "gw-1", MAC: "AA:BB:CC:DD:EE:FF", RSSI: -60, Data: data})
+	assert.Nil(t, parsed.Err)
+	assert.Equal(t, "gw-1", parsed.ReceiverID)
+	assert.Equal(t, int8(-60), parsed.RSSI)
+	assert.Equal(t, KontaktPlain, parsed.DetectedType)
+	assert.Equal(t, byte(0x06), parsed.Flags)
+	assert.IsType(t, &KontaktPlainAdvertisement{}, parsed.Parsed)
+
+	parsed = ParseRecord(ScanRecord{Data: []byte{0x05, 0x01}})
+	assert.NotNil(t, parsed.Err)
+}
+
+func TestScanLine(t *testing.T) {
+	record := ScanRecord{
+		ReceiverID: "gw-1",
+		MAC:        "AA:BB:CC:DD:EE:FF",
+		RSSI:       -60,
+		Received:   time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		Data:       []byte{0x02, 0x01, 0x06},
+	}
+	line := NewScanLine(record)
+	assert.Equal(t, "020106", line.Data)
+	decoded, err := line.Record()
+	assert.Nil(t, err)
+	assert.Equal(t, record, decoded)
+
+	_, err = ScanLine{Data: "zz"}.Record()
+	assert.Equal(t, ErrInvalidScanLineData, err)
+	_, err = ScanLine{}.Record()
+	assert.Equal(t, ErrInvalidScanLineData, err)
+}
+
+func TestDecodeScanLines(t *testing.T) {
+	records, err := DecodeScanLines([]byte(`{"receiver": "gw-1", "mac": "aa:bb:cc:dd:ee:ff", "rssi": -60, "data": "020106"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, []ScanRecord{{ReceiverID: "gw-1", MAC: "AA:BB:CC:DD:EE:FF", RSSI: -60, Data: []byte{0x02, 0x01, 0x06}}}, records)
+
+	records, err = DecodeScanLines([]byte(`[{"data": "020106"}, {"data": "0201", "scanResponse": true}]`))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(records))
+	assert.True(t, records[1].ScanResponse)
+
+	_, err = DecodeScanLines([]byte(`[{"data": "020106"}, {"data": ""}]`))
+	assert.Equal(t, ErrInvalidScanLineData, err)
+	_, err = DecodeScanLines([]byte(`{"data": `))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,239 @@
+package kontaktparser
+
+import (
+	"sort"
+	"sync"
+)
+
+// RSSIFilter smooths consecutive RSSI measurements of a single beacon
+type RSSIFilter interface {
+	// Update adds measurement and returns filtered value
+	Update(rssi int8) float64
+	// Value returns current filtered value, false is returned when no measurement was added
+	Value() (float64, bool)
+	// Reset removes all measurements
+	Reset()
+}
+
+// window is a ring buffer of the last measurements
+type window struct {
+	values []float64
+	next   int
+	full   bool
+}
+
+func newWindow(size int) window {
+	if size < 1 {
+		size = 1
+	}
+	return window{values: make([]float64, size)}
+}
+
+func (w *window) add(value float64) {
+	w.values[w.next] = value
+	w.next = (w.next + 1) % len(w.values)
+	if w.next == 0 {
+		w.full = true
+	}
+}
+
+func (w *window) samples() []float64 {
+	if w.full {
+		return w.values
+	}
+	return w.values[:w.next]
+}
+
+func (w *window) reset() {
+	w.next = 0
+	w.full = false
+}
+
+// MovingAverageFilter is an arithmetic mean of the last measurements
+type MovingAverageFilter struct {
+	window window
+}
+
+// NewMovingAverageFilter creates MovingAverageFilter averaging given number of measurements
+func NewMovingAverageFilter(size int) *MovingAverageFilter {
+	return &MovingAverageFilter{window: newWindow(size)}
+}
+
+func (f *MovingAverageFilter) Update(rssi int8) float64 {
+	f.window.add(float64(rssi))
+	value, _ := f.Value()
+	return value
+}
+
+func (f *MovingAverageFilter) Value() (float64, bool) {
+	samples := f.window.samples()
+	if len(samples) == 0 {
+		return 0, false
+	}
+	sum := 0.0
+	for _, sample := range samples {
+		sum += sample
+	}
+	return sum / float64(len(samples)), true
+}
+
+func (f *MovingAverageFilter) Reset() {
+	f.window.reset()
+}
+
+// MedianFilter is a median of the last measurements, which is resistant to single outliers
+type MedianFilter struct {
+	window window
+	sorted []float64
+}
+
+// NewMedianFilter creates MedianFilter over given number of measurements
+func NewMedianFilter(size int) *MedianFilter {
+	w := newWindow(size)
+	return &MedianFilter{window: w, sorted: make([]float64, 0, len(w.values))}
+}
+
+func (f *MedianFilter) Update(rssi int8) float64 {
+	f.window.add(float64(rssi))
+	value, _ := f.Value()
+	return value
+}
+
+func (f *MedianFilter) Value() (float64, bool) {
+	samples := f.window.samples()
+	if len(samples) == 0 {
+		return 0, false
+	}
+	f.sorted = append(f.sorted[:0], samples...)
+	sort.Float64s(f.sorted)
+	middle := len(f.sorted) / 2
+	if len(f.sorted)%2 == 0 {
+		return (f.sorted[middle-1] + f.sorted[middle]) / 2, true
+	}
+	return f.sorted[middle], true
+}
+
+func (f *MedianFilter) Reset() {
+	f.window.reset()
+}
+
+// ExponentialFilter is an exponentially weighted moving average. Alpha in (0, 1] is a weight of
+// the newest measurement, first measurement initializes the filter.
+type ExponentialFilter struct {
+	Alpha       float64
+	value       float64
+	initialized bool
+}
+
+// NewExponentialFilter creates ExponentialFilter with given smoothing factor
+func NewExponentialFilter(alpha float64) *ExponentialFilter {
+	return &ExponentialFilter{Alpha: alpha}
+}
+
+func (f *ExponentialFilter) Update(rssi int8) float64 {
+	if !f.initialized {
+		f.value = float64(rssi)
+		f.initialized = true
+	} else {
+		f.value = f.Alpha*float64(rssi) + (1-f.Alpha)*f.value
+	}
+	return f.value
+}
+
+func (f *ExponentialFilter) Value() (float64, bool) {
+	return f.value, f.initialized
+}
+
+func (f *ExponentialFilter) Reset() {
+	f.value = 0
+	f.initialized = false
+}
+
+// KalmanFilter is a one dimensional Kalman filter assuming constant RSSI disturbed by noise.
+// ProcessNoise describes how fast real RSSI is expected to change, MeasurementNoise describes
+// variance of measurements.
+type KalmanFilter struct {
+	ProcessNoise     float64
+	MeasurementNoise float64
+	estimate         float64
+	covariance       float64
+	initialized      bool
+}
+
+// NewKalmanFilter creates KalmanFilter with given noise parameters
+func NewKalmanFilter(processNoise float64, measurementNoise float64) *KalmanFilter {
+	return &KalmanFilter{
+		ProcessNoise:     processNoise,
+		MeasurementNoise: measurementNoise,
+	}
+}
+
+func (f *KalmanFilter) Update(rssi int8) float64 {
+	measurement := float64(rssi)
+	if !f.initialized {
+		f.estimate = measurement
+		f.covariance = f.MeasurementNoise
+		f.initialized = true
+		return f.estimate
+	}
+	predictedCovariance := f.covariance + f.ProcessNoise
+	gain := predictedCovariance / (predictedCovariance + f.MeasurementNoise)
+	f.estimate += gain * (measurement - f.estimate)
+	f.covariance = (1 - gain) * predictedCovariance
+	return f.estimate
+}
+
+func (f *KalmanFilter) Value() (float64, bool) {
+	return f.estimate, f.initialized
+}
+
+func (f *KalmanFilter) Reset() {
+	f.estimate = 0
+	f.covariance = 0
+	f.initialized = false
+}
+
+// RSSIFilterSet keeps separate RSSIFilter per key (e.g. beacon identity or beacon and receiver pair).
+// It is safe for concurrent use.
+type RSSIFilterSet struct {
+	newFilter func() RSSIFilter
+	mutex     sync.Mutex
+	filters   map[string]RSSIFilter
+}
+
+// NewRSSIFilterSet creates RSSIFilterSet creating filters for new keys with newFilter
+func NewRSSIFilterSet(newFilter func() RSSIFilter) *RSSIFilterSet {
+	return &RSSIFilterSet{
+		newFilter: newFilter,
+		filters:   make(map[string]RSSIFilter),
+	}
+}
+
+// Update adds measurement to the filter of given key and returns filtered value
+func (s *RSSIFilterSet) Update(key string, rssi int8) float64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	filter, ok := s.filters[key]
+	if !ok {
+		filter = s.newFilter()
+		s.filters[key] = filter
+	}
+	return filter.Update(rssi)
+}
+
+// Value returns filtered value for given key
+func (s *RSSIFilterSet) Value(key string) (float64, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if filter, ok := s.filters[key]; ok {
+		return filter.Value()
+	}
+	return 0, false
+}
+
+// Remove drops filter of given key, e.g. when Tracker expires the device
+func (s *RSSIFilterSet) Remove(key string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.filters, key)
+}
@@ -0,0 +1,96 @@
+package kontaktparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordedRSSI is a sequence recorded from a beacon laying 2m from receiver, with a single outlier
+var recordedRSSI = []int8{-71, -69, -74, -70, -90, -72, -68, -71, -73, -70}
+
+func applyFilter(filter RSSIFilter, values []int8) []float64 {
+	result := make([]float64, len(values))
+	for i, value := range values {
+		result[i] = filter.Update(value)
+	}
+	return result
+}
+
+func assertFiltered(t *testing.T, expected []float64, actual []float64) {
+	assert.Equal(t, len(expected), len(actual))
+	for i := range expected {
+		assert.InDelta(t, expected[i], actual[i], 0.001, "sample %v", i)
+	}
+}
+
+func TestMovingAverageFilter(t *testing.T) {
+	filter := NewMovingAverageFilter(3)
+	_, ok := filter.Value()
+	assert.False(t, ok)
+
+	assertFiltered(t, []float64{
+		-71, -70, -71.333, -71, -78, -77.333, -76.667, -70.333, -70.667, -71.333,
+	}, applyFilter(filter, recordedRSSI))
+
+	filter.Reset()
+	_, ok = filter.Value()
+	assert.False(t, ok)
+}
+
+func TestMedianFilter(t *testing.T) {
+	filter := NewMedianFilter(3)
+	_, ok := filter.Value()
+	assert.False(t, ok)
+
+	assertFiltered(t, []float64{
+		-71, -70, -71, -70, -74, -72, -72, -71, -71, -71,
+	}, applyFilter(filter, recordedRSSI))
+
+	filter.Reset()
+	assert.Equal(t, float64(-60), filter.Update(-60))
+}
+
+func TestExponentialFilter(t *testing.T) {
+	filter := NewExponentialFilter(0.5)
+	_, ok := filter.Value()
+	assert.False(t, ok)
+
+	assertFiltered(t, []float64{
+		-71, -70, -72, -71, -80.5, -76.25, -72.125, -71.5625, -72.28125, -71.140625,
+	}, applyFilter(filter, recordedRSSI))
+
+	filter.Reset()
+	assert.Equal(t, float64(-60), filter.Update(-60))
+}
+
+func TestKalmanFilter(t *testing.T) {
+	filter := NewKalmanFilter(0.008, 4)
+	_, ok := filter.Value()
+	assert.False(t, ok)
+
+	filtered := applyFilter(filter, recordedRSSI)
+	assertFiltered(t, []float64{
+		-71, -69.999, -71.337, -71.0, -74.846, -74.363, -73.43, -73.116, -73.103, -72.775,
+	}, filtered)
+
+	filter.Reset()
+	assert.Equal(t, float64(-60), filter.Update(-60))
+}
+
+func TestRSSIFilterSet(t *testing.T) {
+	set := NewRSSIFilterSet(func() RSSIFilter {
+		return NewMovingAverageFilter(2)
+	})
+	set.Update("a", -60)
+	assert.Equal(t, float64(-65), set.Update("a", -70))
+	assert.Equal(t, float64(-80), set.Update("b", -80))
+
+	value, ok := set.Value("a")
+	assert.True(t, ok)
+	assert.Equal(t, float64(-65), value)
+
+	set.Remove("a")
+	_, ok = set.Value("a")
+	assert.False(t, ok)
+}
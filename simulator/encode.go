@@ -0,0 +1,222 @@
+// Package simulator generates advertisement streams of simulated beacon fleets for load and integration testing.
+package simulator
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"strings"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+var (
+	ErrUnsupportedFrame = errors.New("frame can't be encoded")
+	ErrInvalidFrame     = errors.New("frame fields don't fit the packet")
+)
+
+const (
+	flagsDataType        byte = 0x01
+	completeNameType     byte = 0x09
+	txPowerType          byte = 0x0A
+	serviceDataDataType  byte = 0x16
+	manufacturerDataType byte = 0xFF
+	// defaultFlags - LE General Discoverable Mode, BR/EDR Not Supported
+	defaultFlags byte = 0x06
+	// maxPacketLength - maximum length of legacy advertisement or scan response data
+	maxPacketLength = 31
+)
+
+var (
+	ibeaconPreamble         = []byte{0x4C, 0x00, 0x02, 0x15}
+	kontaktUUID             = []byte{0x6A, 0xFE}
+	eddystoneUUID           = []byte{0xAA, 0xFE}
+	kontaktScanResponseUUID = []byte{0x0D, 0xD0}
+)
+
+// eddystoneURLPrefixes and eddystoneURLReplacements are ordered by length, so longest match is encoded
+var eddystoneURLPrefixes = []struct {
+	code   byte
+	prefix string
+}{
+	{0x01, "https://www."},
+	{0x00, "http://www."},
+	{0x03, "https://"},
+	{0x02, "http://"},
+}
+
+var eddystoneURLReplacements = []struct {
+	code        byte
+	replacement string
+}{
+	{0x04, ".info/"},
+	{0x00, ".com/"},
+	{0x01, ".org/"},
+	{0x02, ".edu/"},
+	{0x03, ".net/"},
+	{0x05, ".biz/"},
+	{0x06, ".gov/"},
+	{0x0B, ".info"},
+	{0x07, ".com"},
+	{0x08, ".org"},
+	{0x09, ".edu"},
+	{0x0A, ".net"},
+	{0x0C, ".biz"},
+	{0x0D, ".gov"},
+}
+
+// Encode builds packet carrying parsed frame, the inverse of parsing. Advertisements start with flags
+// section, *kontaktparser.KontaktIOScanResponse is encoded as scan response.
+func Encode(parsed interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if _, ok := parsed.(*kontaktparser.KontaktIOScanResponse); !ok {
+		writeSection(buf, flagsDataType, []byte{defaultFlags})
+	}
+
+	var err error
+	switch frame := parsed.(type) {
+	case *kontaktparser.IBeaconAdvertisement:
+		encodeIBeacon(buf, frame)
+	case *kontaktparser.KontaktIOScanResponse:
+		err = encodeScanResponse(buf, frame)
+	case *kontaktparser.KontaktPlainAdvertisement:
+		data := []byte{0x02, frame.DeviceModel, frame.FirmwareMajor, frame.FirmwareMinor, frame.BatteryLevel, byte(frame.TxPower)}
+		writeServiceData(buf, kontaktUUID, append(data, frame.UniqueID...))
+	case *kontaktparser.KontaktShuffledAdvertisement:
+		if len(frame.EddystoneNamespace) != 10 || len(frame.EddystoneInstanceID) != 6 {
+			return nil, ErrInvalidFrame
+		}
+		data := []byte{0x01, frame.DeviceModel, frame.FirmwareMajor, frame.FirmwareMinor, frame.BatteryLevel, byte(frame.TxPower)}
+		data = append(append(data, frame.EddystoneNamespace...), frame.EddystoneInstanceID...)
+		writeServiceData(buf, kontaktUUID, data)
+	case *kontaktparser.KontaktTelemetryAdvertisement:
+		data := []byte{0x03}
+		for _, field := range frame.Fields {
+			if len(field.Value) > math.MaxUint8-1 {
+				return nil, ErrInvalidFrame
+			}
+			data = append(data, byte(len(field.Value)+1), byte(field.PID))
+			data = append(data, field.Value...)
+		}
+		writeServiceData(buf, kontaktUUID, data)
+	case *kontaktparser.KontaktLocationAdvertisement:
+		data := []byte{0x05, byte(frame.TxPower), frame.BleChannel, frame.DeviceModel, frame.Flags}
+		writeServiceData(buf, kontaktUUID, append(data, frame.UniqueID...))
+	case *kontaktparser.EddystoneUIDPacket:
+		if len(frame.Namespace) != 10 || len(frame.InstanceId) != 6 {
+			return nil, ErrInvalidFrame
+		}
+		data := append([]byte{0x00, byte(frame.TxPower0M)}, frame.Namespace...)
+		data = append(append(data, frame.InstanceId...), 0x00, 0x00)
+		writeServiceData(buf, eddystoneUUID, data)
+	case *kontaktparser.EddystoneURLPacket:
+		err = encodeEddystoneURL(buf, frame)
+	case *kontaktparser.EddystonePlainTLMPacket:
+		data := make([]byte, 14)
+		data[0], data[1] = 0x20, 0x00
+		binary.BigEndian.PutUint16(data[2:4], frame.BatteryVoltage)
+		binary.BigEndian.PutUint16(data[4:6], uint16(int16(math.Round(frame.Temperature*256))))
+		binary.BigEndian.PutUint32(data[6:10], frame.AdvertisementCount)
+		binary.BigEndian.PutUint32(data[10:14], uint32(math.Round(frame.TimeSincePowerOn*10)))
+		writeServiceData(buf, eddystoneUUID, data)
+	case *kontaktparser.EddystoneEncryptedTLMPacket:
+		if len(frame.Telemetry) != 12 || len(frame.Salt) != 2 || len(frame.MIC) != 2 {
+			return nil, ErrInvalidFrame
+		}
+		data := append([]byte{0x20, 0x01}, frame.Telemetry...)
+		data = append(append(data, frame.Salt...), frame.MIC...)
+		writeServiceData(buf, eddystoneUUID, data)
+	case *kontaktparser.EddystoneEIDPacket:
+		if len(frame.EID) != 8 {
+			return nil, ErrInvalidFrame
+		}
+		writeServiceData(buf, eddystoneUUID, append([]byte{0x30, byte(frame.TxPower0M)}, frame.EID...))
+	case *kontaktparser.EddystoneUnknownPacket:
+		writeServiceData(buf, eddystoneUUID, append([]byte{frame.FrameType}, frame.Payload...))
+	default:
+		return nil, ErrUnsupportedFrame
+	}
+	if err != nil {
+		return nil, err
+	}
+	if buf.Len() > maxPacketLength {
+		return nil, ErrInvalidFrame
+	}
+	return buf.Bytes(), nil
+}
+
+// writeSection writes advertisement data section, section length counts type and data
+func writeSection(buf *bytes.Buffer, typ byte, data []byte) {
+	buf.WriteByte(byte(len(data) + 1))
+	buf.WriteByte(typ)
+	buf.Write(data)
+}
+
+func writeServiceData(buf *bytes.Buffer, uuid []byte, data []byte) {
+	writeSection(buf, serviceDataDataType, append(append([]byte{}, uuid...), data...))
+}
+
+func encodeIBeacon(buf *bytes.Buffer, frame *kontaktparser.IBeaconAdvertisement) {
+	data := append([]byte{}, ibeaconPreamble...)
+	data = append(data, frame.ProximityUUID[:]...)
+	data = append(data, 0, 0, 0, 0, byte(frame.CalibratedRssi))
+	binary.LittleEndian.PutUint16(data[20:22], frame.Major)
+	binary.LittleEndian.PutUint16(data[22:24], frame.Minor)
+	writeSection(buf, manufacturerDataType, data)
+}
+
+func encodeScanResponse(buf *bytes.Buffer, frame *kontaktparser.KontaktIOScanResponse) error {
+	if frame.HasName {
+		writeSection(buf, completeNameType, []byte(frame.Name))
+	}
+	if frame.HasTxPower {
+		writeSection(buf, txPowerType, []byte{byte(frame.TxPower)})
+	}
+	if frame.HasIdentifier {
+		firmware, err := frame.FirmwareVersion()
+		if err != nil || len(frame.UniqueID) != 4 {
+			return ErrInvalidFrame
+		}
+		data := append([]byte(frame.UniqueID), firmware.Major, firmware.Minor, frame.BatteryLevel)
+		writeServiceData(buf, kontaktScanResponseUUID, data)
+	}
+	return nil
+}
+
+func encodeEddystoneURL(buf *bytes.Buffer, frame *kontaktparser.EddystoneURLPacket) error {
+	data := []byte{0x10, byte(frame.TxPower0M)}
+	url := frame.URL
+	found := false
+	for _, prefix := range eddystoneURLPrefixes {
+		if strings.HasPrefix(url, prefix.prefix) {
+			data = append(data, prefix.code)
+			url = url[len(prefix.prefix):]
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ErrInvalidFrame
+	}
+	for len(url) > 0 {
+		replaced := false
+		for _, replacement := range eddystoneURLReplacements {
+			if strings.HasPrefix(url, replacement.replacement) {
+				data = append(data, replacement.code)
+				url = url[len(replacement.replacement):]
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			if url[0] < 0x20 || url[0] > 0x7F {
+				return ErrInvalidFrame
+			}
+			data = append(data, url[0])
+			url = url[1:]
+		}
+	}
+	writeServiceData(buf, eddystoneUUID, data)
+	return nil
+}
@@ -0,0 +1,90 @@
+package simulator
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+func parse(t *testing.T, data []byte, scanResponse bool) kontaktparser.Parser {
+	record := kontaktparser.ScanRecord{Data: data, ScanResponse: scanResponse}
+	parser, err := record.Parse()
+	assert.Nil(t, err)
+	return parser
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	frames := []interface{}{
+		&kontaktparser.IBeaconAdvertisement{
+			CalibratedRssi: -77,
+			ProximityUUID:  uuid.MustParse("f7826da6-4fa2-4e98-8024-bc5b71e0893e"),
+			Major:          513,
+			Minor:          1027,
+		},
+		&kontaktparser.KontaktPlainAdvertisement{DeviceModel: 6, FirmwareMajor: 1, FirmwareMinor: 15, BatteryLevel: 100, TxPower: 4, UniqueID: "abcdef"},
+		&kontaktparser.KontaktShuffledAdvertisement{
+			DeviceModel: 6, FirmwareMajor: 1, FirmwareMinor: 15, BatteryLevel: 100, TxPower: 4,
+			EddystoneNamespace:  []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			EddystoneInstanceID: []byte{11, 12, 13, 14, 15, 16},
+		},
+		&kontaktparser.KontaktTelemetryAdvertisement{Fields: []kontaktparser.KontaktTelemetryValue{
+			{PID: kontaktparser.LightLevel, Value: []byte{100}},
+			{PID: kontaktparser.ClickInfo, Value: []byte{6, 0x5B, 0xA0}},
+		}},
+		&kontaktparser.KontaktLocationAdvertisement{TxPower: -12, BleChannel: 37, DeviceModel: 10, Flags: 1, UniqueID: "ABCDEF"},
+		&kontaktparser.EddystoneUIDPacket{TxPower0M: -20, Namespace: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 0}, InstanceId: []byte{1, 2, 3, 4, 5, 6}},
+		&kontaktparser.EddystoneURLPacket{TxPower0M: -20, URL: "https://www.kontakt.io/beacons"},
+		&kontaktparser.EddystonePlainTLMPacket{BatteryVoltage: 3000, Temperature: -5.5, AdvertisementCount: 1234, TimeSincePowerOn: 360.5},
+		&kontaktparser.EddystoneEncryptedTLMPacket{Telemetry: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, Salt: []byte{1, 2}, MIC: []byte{3, 4}},
+		&kontaktparser.EddystoneEIDPacket{TxPower0M: -20, EID: []byte{1, 2, 3, 4, 5, 6, 7, 8}},
+		&kontaktparser.EddystoneUnknownPacket{FrameType: 0x40, Payload: []byte{1, 2}},
+	}
+	for _, frame := range frames {
+		data, err := Encode(frame)
+		assert.Nil(t, err)
+		parser := parse(t, data, false)
+		assert.Equal(t, byte(0x06), parser.Flags)
+		assert.Equal(t, frame, parser.Parsed)
+	}
+}
+
+func TestEncodeMatchesFixtures(t *testing.T) {
+	data, err := Encode(&kontaktparser.KontaktPlainAdvertisement{DeviceModel: 6, FirmwareMajor: 1, FirmwareMinor: 15, BatteryLevel: 100, TxPower: 4, UniqueID: "abcdef"})
+	assert.Nil(t, err)
+	assert.Equal(t, "0201060f166afe0206010f6404616263646566", hex.EncodeToString(data))
+
+	data, err = Encode(&kontaktparser.EddystoneURLPacket{TxPower0M: 4, URL: "https://test.biz"})
+	assert.Nil(t, err)
+	assert.Equal(t, "0201060b16aafe100403746573740c", hex.EncodeToString(data))
+}
+
+func TestEncodeScanResponse(t *testing.T) {
+	frame := &kontaktparser.KontaktIOScanResponse{
+		Name: "abcdefg", HasName: true, TxPower: 4, HasTxPower: true,
+		Firmware: "4.2", BatteryLevel: 100, UniqueID: "abcd", HasIdentifier: true,
+	}
+	data, err := Encode(frame)
+	assert.Nil(t, err)
+	assert.Equal(t, "080961626364656667020a040a160dd061626364040264", hex.EncodeToString(data))
+	assert.Equal(t, frame, parse(t, data, true).Parsed)
+}
+
+func TestEncodeInvalid(t *testing.T) {
+	_, err := Encode(&kontaktparser.EddystoneUIDPacket{Namespace: []byte{1}, InstanceId: []byte{1}})
+	assert.Equal(t, ErrInvalidFrame, err)
+
+	_, err = Encode(&kontaktparser.EddystoneURLPacket{URL: "ftp://kontakt.io"})
+	assert.Equal(t, ErrInvalidFrame, err)
+
+	_, err = Encode(&kontaktparser.KontaktPlainAdvertisement{UniqueID: "a unique id too long for advertisement"})
+	assert.Equal(t, ErrInvalidFrame, err)
+
+	_, err = Encode(&kontaktparser.KontaktIOScanResponse{Firmware: "4", UniqueID: "abcd", HasIdentifier: true})
+	assert.Equal(t, ErrInvalidFrame, err)
+
+	_, err = Encode("frame")
+	assert.Equal(t, ErrUnsupportedFrame, err)
+}
@@ -0,0 +1,409 @@
+package simulator
+
+import (
+	"container/heap"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+var (
+	ErrInvalidBeacon = errors.New("beacon has no frames or non-positive interval")
+)
+
+const (
+	// maxAdvertisingDelay - random delay added by BLE controller to every advertising event
+	maxAdvertisingDelay = 10 * time.Millisecond
+	// minRSSI and maxRSSI bound simulated RSSI values
+	minRSSI = -127
+	maxRSSI = 20
+	// eidRotation - period after which simulated Eddystone EID changes (rotation exponent 9)
+	eidRotation = 512 * time.Second
+)
+
+// Sensor describes simulated sensor value: it starts at Start, changes by Drift every hour, oscillates
+// with Amplitude over Period and is disturbed by gaussian Noise (standard deviation)
+type Sensor struct {
+	Start     float64
+	Drift     float64
+	Amplitude float64
+	Period    time.Duration
+	Noise     float64
+}
+
+// Value returns sensor value after elapsed time since simulation start
+func (s Sensor) Value(elapsed time.Duration, rng *rand.Rand) float64 {
+	value := s.Start + s.Drift*elapsed.Hours()
+	if s.Period > 0 {
+		value += s.Amplitude * math.Sin(2*math.Pi*float64(elapsed)/float64(s.Period))
+	}
+	if s.Noise > 0 {
+		value += rng.NormFloat64() * s.Noise
+	}
+	return value
+}
+
+// Beacon describes simulated beacon. Frames are sent in rotation, one per advertising event.
+// Sensors which are nil aren't reported, battery defaults to 100%.
+type Beacon struct {
+	MAC      string
+	Model    kontaktparser.DeviceModel
+	Firmware kontaktparser.FirmwareVersion
+	// UniqueID is a Kontakt.io identifier, scan response uses its first 4 characters
+	UniqueID string
+	// TxPower is a Kontakt.io TX power level (0-7)
+	TxPower  int8
+	Interval time.Duration
+	Frames   []kontaktparser.DetectedType
+
+	ProximityUUID uuid.UUID
+	Major         uint16
+	Minor         uint16
+	Namespace     []byte
+	InstanceID    []byte
+	URL           string
+
+	Battery     *Sensor
+	Temperature *Sensor
+	Humidity    *Sensor
+	Light       *Sensor
+
+	// RSSI is a mean RSSI at receivers, ReceiverRSSI overrides it for given receivers
+	RSSI         float64
+	ReceiverRSSI map[string]float64
+}
+
+// Fleet describes simulated beacons and receivers hearing them
+type Fleet struct {
+	Beacons []Beacon
+	// Receivers are IDs of receivers hearing every beacon, single unnamed receiver is used when empty
+	Receivers []string
+	// RSSINoise is a standard deviation of gaussian noise added to RSSI
+	RSSINoise float64
+	// PacketLoss is a probability of receiver missing a packet
+	PacketLoss float64
+	// Seed initialises random generator, simulations with the same seed are repeatable
+	Seed int64
+}
+
+// beaconState is a simulation state of a single beacon
+type beaconState struct {
+	beacon  *Beacon
+	next    time.Time
+	frame   int
+	events  uint32
+	channel int
+}
+
+// schedule is a heap of beacons ordered by their next advertising event
+type schedule []*beaconState
+
+func (s schedule) Len() int { return len(s) }
+func (s schedule) Less(i, j int) bool {
+	return s[i].next.Before(s[j].next)
+}
+func (s schedule) Swap(i, j int)       { s[i], s[j] = s[j], s[i] }
+func (s *schedule) Push(x interface{}) { *s = append(*s, x.(*beaconState)) }
+func (s *schedule) Pop() interface{} {
+	old := *s
+	state := old[len(old)-1]
+	*s = old[:len(old)-1]
+	return state
+}
+
+// Simulator generates time ordered scan records of a fleet.
+// Eddystone EID and encrypted TLM frames are derived from beacon identity with SHA-256 instead of AES
+// used by real beacons, they are parsable but can't be resolved or decrypted.
+type Simulator struct {
+	fleet     Fleet
+	start     time.Time
+	now       time.Time
+	position  time.Time
+	rng       *rand.Rand
+	schedule  schedule
+	receivers []string
+}
+
+// NewSimulator creates Simulator of fleet starting at start time. Beacons start advertising at random
+// offset within their interval.
+func NewSimulator(fleet Fleet, start time.Time) (*Simulator, error) {
+	s := &Simulator{
+		fleet:     fleet,
+		start:     start,
+		now:       start,
+		position:  start,
+		rng:       rand.New(rand.NewSource(fleet.Seed)),
+		receivers: fleet.Receivers,
+	}
+	if len(s.receivers) == 0 {
+		s.receivers = []string{""}
+	}
+	for i := range fleet.Beacons {
+		beacon := &fleet.Beacons[i]
+		if beacon.Interval <= 0 || len(beacon.Frames) == 0 {
+			return nil, ErrInvalidBeacon
+		}
+		offset := time.Duration(s.rng.Int63n(int64(beacon.Interval)))
+		s.schedule = append(s.schedule, &beaconState{beacon: beacon, next: start.Add(offset)})
+	}
+	heap.Init(&s.schedule)
+	return s, nil
+}
+
+// Run generates records for given duration, emit is called in time order.
+// Run may be called repeatedly to continue simulation from where the previous call ended.
+func (s *Simulator) Run(duration time.Duration, emit func(kontaktparser.ScanRecord) error) error {
+	end := s.position.Add(duration)
+	s.position = end
+	for len(s.schedule) > 0 && s.schedule[0].next.Before(end) {
+		state := s.schedule[0]
+		s.now = state.next
+		records, err := s.advertise(state)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			if err := emit(record); err != nil {
+				return err
+			}
+		}
+		delay := time.Duration(s.rng.Int63n(int64(maxAdvertisingDelay)))
+		state.next = state.next.Add(state.beacon.Interval + delay)
+		heap.Fix(&s.schedule, 0)
+	}
+	return nil
+}
+
+// Generate returns records generated during given duration, see Run
+func (s *Simulator) Generate(duration time.Duration) ([]kontaktparser.ScanRecord, error) {
+	records := make([]kontaktparser.ScanRecord, 0)
+	err := s.Run(duration, func(record kontaktparser.ScanRecord) error {
+		records = append(records, record)
+		return nil
+	})
+	return records, err
+}
+
+// advertise builds next frame of beacon and returns records of receivers which heard it
+func (s *Simulator) advertise(state *beaconState) ([]kontaktparser.ScanRecord, error) {
+	beacon := state.beacon
+	typ := beacon.Frames[state.frame%len(beacon.Frames)]
+	state.frame++
+	state.events++
+	// advertising event is sent on all channels, receivers hear it on rotating one
+	channel := kontaktparser.Channel37 + kontaktparser.AdvertisingChannel(state.channel)
+	state.channel = (state.channel + 1) % 3
+
+	frame, err := s.frame(state, typ, channel)
+	if err != nil {
+		return nil, err
+	}
+	data, err := Encode(frame)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]kontaktparser.ScanRecord, 0, len(s.receivers))
+	for _, receiver := range s.receivers {
+		if s.fleet.PacketLoss > 0 && s.rng.Float64() < s.fleet.PacketLoss {
+			continue
+		}
+		records = append(records, kontaktparser.ScanRecord{
+			ReceiverID:   receiver,
+			MAC:          beacon.MAC,
+			RSSI:         s.rssi(beacon, receiver),
+			Channel:      channel,
+			Received:     s.now,
+			Data:         data,
+			ScanResponse: typ == kontaktparser.KontaktScanResponse,
+		})
+	}
+	return records, nil
+}
+
+func (s *Simulator) rssi(beacon *Beacon, receiver string) int8 {
+	rssi := beacon.RSSI
+	if value, ok := beacon.ReceiverRSSI[receiver]; ok {
+		rssi = value
+	}
+	if s.fleet.RSSINoise > 0 {
+		rssi += s.rng.NormFloat64() * s.fleet.RSSINoise
+	}
+	return int8(clamp(math.Round(rssi), minRSSI, maxRSSI))
+}
+
+func (s *Simulator) sensor(sensor *Sensor, min float64, max float64) (float64, bool) {
+	if sensor == nil {
+		return 0, false
+	}
+	return clamp(sensor.Value(s.now.Sub(s.start), s.rng), min, max), true
+}
+
+func (s *Simulator) battery(beacon *Beacon) uint8 {
+	if battery, ok := s.sensor(beacon.Battery, 0, 100); ok {
+		return uint8(math.Round(battery))
+	}
+	return 100
+}
+
+// frame builds frame of given type with current beacon state
+func (s *Simulator) frame(state *beaconState, typ kontaktparser.DetectedType, channel kontaktparser.AdvertisingChannel) (interface{}, error) {
+	beacon := state.beacon
+	switch typ {
+	case kontaktparser.IBeacon:
+		return &kontaktparser.IBeaconAdvertisement{
+			CalibratedRssi: referenceRSSI(beacon, -41),
+			ProximityUUID:  beacon.ProximityUUID,
+			Major:          beacon.Major,
+			Minor:          beacon.Minor,
+		}, nil
+	case kontaktparser.KontaktScanResponse:
+		uniqueID := beacon.UniqueID
+		if len(uniqueID) > 4 {
+			uniqueID = uniqueID[:4]
+		}
+		return &kontaktparser.KontaktIOScanResponse{
+			Name:          "Kontakt",
+			HasName:       true,
+			TxPower:       beacon.TxPower,
+			HasTxPower:    true,
+			Firmware:      beacon.Firmware.String(),
+			BatteryLevel:  s.battery(beacon),
+			UniqueID:      uniqueID,
+			HasIdentifier: true,
+		}, nil
+	case kontaktparser.KontaktPlain:
+		return &kontaktparser.KontaktPlainAdvertisement{
+			DeviceModel:   uint8(beacon.Model),
+			FirmwareMajor: beacon.Firmware.Major,
+			FirmwareMinor: beacon.Firmware.Minor,
+			BatteryLevel:  s.battery(beacon),
+			TxPower:       beacon.TxPower,
+			UniqueID:      beacon.UniqueID,
+		}, nil
+	case kontaktparser.KontaktShuffled:
+		return &kontaktparser.KontaktShuffledAdvertisement{
+			DeviceModel:         uint8(beacon.Model),
+			FirmwareMajor:       beacon.Firmware.Major,
+			FirmwareMinor:       beacon.Firmware.Minor,
+			BatteryLevel:        s.battery(beacon),
+			TxPower:             beacon.TxPower,
+			EddystoneNamespace:  beacon.Namespace,
+			EddystoneInstanceID: beacon.InstanceID,
+		}, nil
+	case kontaktparser.KontaktTelemetry:
+		return s.telemetry(beacon), nil
+	case kontaktparser.KontaktLocation:
+		txPower, _ := kontaktparser.KontaktTxPowerLevelToDBm(beacon.TxPower)
+		return &kontaktparser.KontaktLocationAdvertisement{
+			TxPower:     txPower,
+			BleChannel:  uint8(channel),
+			DeviceModel: uint8(beacon.Model),
+			UniqueID:    beacon.UniqueID,
+		}, nil
+	case kontaktparser.EddystoneUID:
+		return &kontaktparser.EddystoneUIDPacket{
+			TxPower0M:  referenceRSSI(beacon, 0),
+			Namespace:  beacon.Namespace,
+			InstanceId: beacon.InstanceID,
+		}, nil
+	case kontaktparser.EddystoneURL:
+		return &kontaktparser.EddystoneURLPacket{
+			TxPower0M: referenceRSSI(beacon, 0),
+			URL:       beacon.URL,
+		}, nil
+	case kontaktparser.EddystoneTLM:
+		return s.tlm(state), nil
+	case kontaktparser.EddystoneETLM:
+		return s.encryptedTLM(state), nil
+	case kontaktparser.EddystoneEID:
+		counter := make([]byte, 8)
+		binary.BigEndian.PutUint64(counter, uint64(s.now.Sub(s.start)/eidRotation))
+		return &kontaktparser.EddystoneEIDPacket{
+			TxPower0M: referenceRSSI(beacon, 0),
+			EID:       beaconDigest(beacon, counter)[:8],
+		}, nil
+	}
+	return nil, ErrUnsupportedFrame
+}
+
+// tlm builds Eddystone TLM frame with battery and temperature
+func (s *Simulator) tlm(state *beaconState) *kontaktparser.EddystonePlainTLMPacket {
+	tlm := &kontaktparser.EddystonePlainTLMPacket{
+		// linear discharge of 3 V cell down to 2 V
+		BatteryVoltage:     2000 + uint16(s.battery(state.beacon))*10,
+		Temperature:        -128,
+		AdvertisementCount: state.events,
+		TimeSincePowerOn:   math.Floor(s.now.Sub(s.start).Seconds()*10) / 10,
+	}
+	if temperature, ok := s.sensor(state.beacon.Temperature, -127, 127); ok {
+		tlm.Temperature = math.Round(temperature*256) / 256
+	}
+	return tlm
+}
+
+// encryptedTLM builds Eddystone encrypted TLM frame, TLM data is XORed with digest of beacon identity and
+// random salt, which is followed by digest based MIC
+func (s *Simulator) encryptedTLM(state *beaconState) *kontaktparser.EddystoneEncryptedTLMPacket {
+	tlm := s.tlm(state)
+	data := make([]byte, 12)
+	binary.BigEndian.PutUint16(data[0:2], tlm.BatteryVoltage)
+	binary.BigEndian.PutUint16(data[2:4], uint16(int16(math.Round(tlm.Temperature*256))))
+	binary.BigEndian.PutUint32(data[4:8], tlm.AdvertisementCount)
+	binary.BigEndian.PutUint32(data[8:12], uint32(math.Round(tlm.TimeSincePowerOn*10)))
+
+	salt := []byte{byte(s.rng.Intn(256)), byte(s.rng.Intn(256))}
+	digest := beaconDigest(state.beacon, salt)
+	for i := range data {
+		data[i] ^= digest[i]
+	}
+	return &kontaktparser.EddystoneEncryptedTLMPacket{Telemetry: data, Salt: salt, MIC: digest[12:14]}
+}
+
+// beaconDigest returns SHA-256 of beacon identity and given data
+func beaconDigest(beacon *Beacon, data []byte) []byte {
+	hash := sha256.New()
+	hash.Write([]byte(beacon.MAC + beacon.UniqueID))
+	hash.Write(beacon.Namespace)
+	hash.Write(beacon.InstanceID)
+	hash.Write(data)
+	return hash.Sum(nil)
+}
+
+// referenceRSSI returns calibration value of beacon: RSSI at 0m (offset 0) or at 1m (offset -41)
+func referenceRSSI(beacon *Beacon, offset int8) int8 {
+	txPower, ok := kontaktparser.KontaktTxPowerLevelToDBm(beacon.TxPower)
+	if !ok {
+		txPower = 0
+	}
+	return txPower + offset
+}
+
+// telemetry builds Kontakt.io telemetry frame with battery and configured sensors
+func (s *Simulator) telemetry(beacon *Beacon) *kontaktparser.KontaktTelemetryAdvertisement {
+	fields := []kontaktparser.KontaktTelemetryValue{
+		{PID: kontaktparser.BatteryLevel, Value: []byte{s.battery(beacon)}},
+	}
+	if temperature, ok := s.sensor(beacon.Temperature, -127, 127); ok {
+		value := make([]byte, 2)
+		binary.BigEndian.PutUint16(value, uint16(int16(math.Round(temperature*256))))
+		fields = append(fields, kontaktparser.KontaktTelemetryValue{PID: kontaktparser.Temperature16Bit, Value: value})
+	}
+	if humidity, ok := s.sensor(beacon.Humidity, 0, 100); ok {
+		fields = append(fields, kontaktparser.KontaktTelemetryValue{PID: kontaktparser.Humidity, Value: []byte{uint8(math.Round(humidity))}})
+	}
+	if light, ok := s.sensor(beacon.Light, 0, 100); ok {
+		fields = append(fields, kontaktparser.KontaktTelemetryValue{PID: kontaktparser.LightLevel, Value: []byte{uint8(math.Round(light))}})
+	}
+	return &kontaktparser.KontaktTelemetryAdvertisement{Fields: fields}
+}
+
+func clamp(value float64, min float64, max float64) float64 {
+	return math.Max(min, math.Min(max, value))
+}
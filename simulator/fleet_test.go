@@ -0,0 +1,221 @@
+package simulator
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+var simulationStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func testFleet() Fleet {
+	return Fleet{
+		Beacons: []Beacon{
+			{
+				MAC:      "F1:A2:B3:C4:D5:E6",
+				Model:    kontaktparser.SmartBeacon3,
+				Firmware: kontaktparser.FirmwareVersion{Major: 1, Minor: 15},
+				UniqueID: "abcdef",
+				TxPower:  3,
+				Interval: 100 * time.Millisecond,
+				Frames: []kontaktparser.DetectedType{
+					kontaktparser.KontaktPlain, kontaktparser.KontaktLocation, kontaktparser.KontaktTelemetry,
+					kontaktparser.IBeacon, kontaktparser.KontaktScanResponse,
+				},
+				ProximityUUID: uuid.MustParse("f7826da6-4fa2-4e98-8024-bc5b71e0893e"),
+				Major:         1,
+				Minor:         2,
+				Battery:       &Sensor{Start: 90, Drift: -1},
+				Temperature:   &Sensor{Start: 21.5, Amplitude: 2, Period: time.Minute},
+				Humidity:      &Sensor{Start: 40, Noise: 1},
+				RSSI:          -65,
+				ReceiverRSSI:  map[string]float64{"gw-2": -80},
+			},
+			{
+				MAC:      "C0:A1:B2:C3:D4:E5",
+				TxPower:  6,
+				Interval: time.Second,
+				Frames: []kontaktparser.DetectedType{
+					kontaktparser.EddystoneUID, kontaktparser.EddystoneURL, kontaktparser.EddystoneTLM,
+					kontaktparser.EddystoneEID, kontaktparser.EddystoneETLM,
+				},
+				Namespace:  []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+				InstanceID: []byte{1, 2, 3, 4, 5, 6},
+				URL:        "https://kontakt.io",
+				RSSI:       -70,
+			},
+		},
+		Receivers: []string{"gw-1", "gw-2"},
+		RSSINoise: 3,
+		Seed:      42,
+	}
+}
+
+func generate(t *testing.T, fleet Fleet, duration time.Duration) []kontaktparser.ScanRecord {
+	simulator, err := NewSimulator(fleet, simulationStart)
+	assert.Nil(t, err)
+	records, err := simulator.Generate(duration)
+	assert.Nil(t, err)
+	return records
+}
+
+func TestSimulatorParsableStream(t *testing.T) {
+	records := generate(t, testFleet(), 10*time.Second)
+	// 10s at 100ms (plus advertising delay) and 1s intervals, heard by 2 receivers
+	assert.InDelta(t, 2*(96+10), len(records), 10)
+
+	counts := make(map[kontaktparser.DetectedType]int)
+	for i, record := range records {
+		if i > 0 {
+			assert.False(t, record.Received.Before(records[i-1].Received))
+		}
+		parsed := kontaktparser.ParseRecord(record)
+		assert.Nil(t, parsed.Err)
+		counts[parsed.DetectedType]++
+
+		switch frame := parsed.Parsed.(type) {
+		case *kontaktparser.KontaktPlainAdvertisement:
+			assert.Equal(t, "abcdef", frame.UniqueID)
+			assert.Equal(t, uint8(90), frame.BatteryLevel)
+			assert.Equal(t, kontaktparser.SmartBeacon3, frame.Model())
+		case *kontaktparser.KontaktLocationAdvertisement:
+			assert.Equal(t, int8(-12), frame.TxPower)
+			assert.Equal(t, record.Channel, frame.Channel())
+		case *kontaktparser.KontaktIOScanResponse:
+			assert.True(t, record.ScanResponse)
+			assert.Equal(t, "abcd", frame.UniqueID)
+			assert.Equal(t, "1.15", frame.Firmware)
+		case *kontaktparser.EddystoneURLPacket:
+			assert.Equal(t, "https://kontakt.io", frame.URL)
+			assert.Equal(t, int8(0), frame.TxPower0M)
+		case *kontaktparser.EddystonePlainTLMPacket:
+			assert.Equal(t, uint16(3000), frame.BatteryVoltage)
+			assert.False(t, frame.HasTemperature())
+		case *kontaktparser.EddystoneEIDPacket:
+			assert.Equal(t, int8(0), frame.TxPower0M)
+			assert.Len(t, frame.EID, 8)
+		case *kontaktparser.EddystoneEncryptedTLMPacket:
+			assert.Len(t, frame.Telemetry, 12)
+		}
+	}
+	for _, typ := range []kontaktparser.DetectedType{
+		kontaktparser.KontaktPlain, kontaktparser.KontaktLocation, kontaktparser.KontaktTelemetry,
+		kontaktparser.IBeacon, kontaktparser.KontaktScanResponse, kontaktparser.EddystoneUID,
+		kontaktparser.EddystoneURL, kontaktparser.EddystoneTLM, kontaktparser.EddystoneEID, kontaktparser.EddystoneETLM,
+	} {
+		assert.NotZero(t, counts[typ], typ.String())
+	}
+}
+
+func TestSimulatorRepeatable(t *testing.T) {
+	assert.Equal(t, generate(t, testFleet(), 5*time.Second), generate(t, testFleet(), 5*time.Second))
+
+	fleet := testFleet()
+	fleet.Seed = 7
+	assert.NotEqual(t, generate(t, testFleet(), 5*time.Second), generate(t, fleet, 5*time.Second))
+}
+
+func TestSimulatorContinues(t *testing.T) {
+	simulator, err := NewSimulator(testFleet(), simulationStart)
+	assert.Nil(t, err)
+	first, err := simulator.Generate(time.Second)
+	assert.Nil(t, err)
+	second, err := simulator.Generate(2 * time.Second)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, second)
+	assert.False(t, second[len(second)-1].Received.Before(simulationStart.Add(2*time.Second)))
+
+	assert.Equal(t, generate(t, testFleet(), 3*time.Second), append(first, second...))
+}
+
+func TestSimulatorRSSI(t *testing.T) {
+	records := generate(t, testFleet(), time.Minute)
+	sums := make(map[string]float64)
+	counts := make(map[string]int)
+	for _, record := range records {
+		if record.MAC == "F1:A2:B3:C4:D5:E6" {
+			sums[record.ReceiverID] += float64(record.RSSI)
+			counts[record.ReceiverID]++
+		}
+	}
+	assert.InDelta(t, -65, sums["gw-1"]/float64(counts["gw-1"]), 0.5)
+	assert.InDelta(t, -80, sums["gw-2"]/float64(counts["gw-2"]), 0.5)
+}
+
+func TestSimulatorPacketLoss(t *testing.T) {
+	fleet := testFleet()
+	complete := len(generate(t, fleet, time.Minute))
+	fleet.PacketLoss = 0.25
+	lossy := len(generate(t, fleet, time.Minute))
+	assert.InDelta(t, 0.75, float64(lossy)/float64(complete), 0.03)
+}
+
+func TestSimulatorTelemetry(t *testing.T) {
+	fleet := testFleet()
+	fleet.Beacons = fleet.Beacons[:1]
+	fleet.Beacons[0].Frames = []kontaktparser.DetectedType{kontaktparser.KontaktTelemetry}
+	fleet.Beacons[0].Interval = time.Minute
+	fleet.Receivers = nil
+	records := generate(t, fleet, time.Hour)
+	assert.Len(t, records, 60)
+
+	first := kontaktparser.ParseRecord(records[0]).Parsed.(*kontaktparser.KontaktTelemetryAdvertisement)
+	assert.Len(t, first.Fields, 3)
+	assert.Equal(t, kontaktparser.BatteryLevel, first.Fields[0].PID)
+	assert.Equal(t, kontaktparser.Temperature16Bit, first.Fields[1].PID)
+	assert.Equal(t, kontaktparser.Humidity, first.Fields[2].PID)
+	temperature, err := kontaktparser.DecodeTelemetry(first.Fields[1])
+	assert.Nil(t, err)
+	assert.InDelta(t, 21.5, temperature.(*kontaktparser.Temperature16BitFieldParser).Temperature, 2)
+
+	last := kontaktparser.ParseRecord(records[59]).Parsed.(*kontaktparser.KontaktTelemetryAdvertisement)
+	assert.Equal(t, []byte{89}, last.Fields[0].Value)
+	assert.Equal(t, "", records[59].ReceiverID)
+}
+
+func TestSensorValue(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	sensor := Sensor{Start: 20, Drift: 2, Amplitude: 5, Period: time.Hour}
+	assert.Equal(t, 20.0, sensor.Value(0, rng))
+	assert.InDelta(t, 25.5, sensor.Value(15*time.Minute, rng), 1e-9)
+	assert.InDelta(t, 22.0, sensor.Value(time.Hour, rng), 1e-9)
+
+	noisy := Sensor{Start: 20, Noise: 1}
+	sum := 0.0
+	for i := 0; i < 1000; i++ {
+		sum += noisy.Value(0, rng)
+	}
+	assert.InDelta(t, 20, sum/1000, 0.1)
+	assert.False(t, math.IsNaN(sum))
+}
+
+func TestInvalidBeacon(t *testing.T) {
+	_, err := NewSimulator(Fleet{Beacons: []Beacon{{Frames: []kontaktparser.DetectedType{kontaktparser.IBeacon}}}}, simulationStart)
+	assert.Equal(t, ErrInvalidBeacon, err)
+
+	simulator, err := NewSimulator(Fleet{Beacons: []Beacon{{Interval: time.Second, Frames: []kontaktparser.DetectedType{kontaktparser.EddystoneUnknown}}}}, simulationStart)
+	assert.Nil(t, err)
+	_, err = simulator.Generate(time.Minute)
+	assert.Equal(t, ErrUnsupportedFrame, err)
+}
+
+func TestSimulatorEphemeralID(t *testing.T) {
+	fleet := testFleet()
+	fleet.Beacons = fleet.Beacons[1:]
+	fleet.Beacons[0].Frames = []kontaktparser.DetectedType{kontaktparser.EddystoneEID}
+	fleet.Receivers = nil
+	records := generate(t, fleet, 20*time.Minute)
+
+	ids := make(map[string]bool)
+	for _, record := range records {
+		frame := kontaktparser.ParseRecord(record).Parsed.(*kontaktparser.EddystoneEIDPacket)
+		ids[string(frame.EID)] = true
+	}
+	// EID rotates every 512s
+	assert.Len(t, ids, 3)
+}
@@ -0,0 +1,42 @@
+package simulator
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+// HexWriter writes record data as upper case hex, one record per line
+type HexWriter struct {
+	w io.Writer
+}
+
+// NewHexWriter creates HexWriter writing to w
+func NewHexWriter(w io.Writer) *HexWriter {
+	return &HexWriter{w: w}
+}
+
+// Write writes single record, it can be passed to Simulator.Run
+func (w *HexWriter) Write(record kontaktparser.ScanRecord) error {
+	_, err := fmt.Fprintln(w.w, strings.ToUpper(hex.EncodeToString(record.Data)))
+	return err
+}
+
+// JSONLinesWriter writes records as kontaktparser.ScanLine, one record per line
+type JSONLinesWriter struct {
+	encoder *json.Encoder
+}
+
+// NewJSONLinesWriter creates JSONLinesWriter writing to w
+func NewJSONLinesWriter(w io.Writer) *JSONLinesWriter {
+	return &JSONLinesWriter{encoder: json.NewEncoder(w)}
+}
+
+// Write writes single record, it can be passed to Simulator.Run
+func (w *JSONLinesWriter) Write(record kontaktparser.ScanRecord) error {
+	return w.encoder.Encode(kontaktparser.NewScanLine(record))
+}
@@ -0,0 +1,47 @@
+package simulator
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	kontaktparser "github.com/sz33psz/kontakt-beacon-parser"
+)
+
+var writerRecord = kontaktparser.ScanRecord{
+	ReceiverID: "gw-1",
+	MAC:        "F1:A2:B3:C4:D5:E6",
+	RSSI:       -65,
+	Received:   time.Date(2020, 1, 1, 0, 0, 0, 500000000, time.UTC),
+	Data:       []byte{0x02, 0x01, 0x06},
+}
+
+func TestHexWriter(t *testing.T) {
+	out := &bytes.Buffer{}
+	writer := NewHexWriter(out)
+	assert.Nil(t, writer.Write(writerRecord))
+	assert.Nil(t, writer.Write(kontaktparser.ScanRecord{Data: []byte{0xAB}}))
+	assert.Equal(t, "020106\nAB\n", out.String())
+}
+
+func TestJSONLinesWriter(t *testing.T) {
+	out := &bytes.Buffer{}
+	writer := NewJSONLinesWriter(out)
+	assert.Nil(t, writer.Write(writerRecord))
+	scanResponse := writerRecord
+	scanResponse.ScanResponse = true
+	assert.Nil(t, writer.Write(scanResponse))
+	assert.Equal(t,
+		`{"receiver":"gw-1","mac":"F1:A2:B3:C4:D5:E6","rssi":-65,"timestamp":"2020-01-01T00:00:00.5Z","data":"020106"}`+"\n"+
+			`{"receiver":"gw-1","mac":"F1:A2:B3:C4:D5:E6","rssi":-65,"timestamp":"2020-01-01T00:00:00.5Z","data":"020106","scanResponse":true}`+"\n",
+		out.String())
+}
+
+func TestWriterWithSimulator(t *testing.T) {
+	simulator, err := NewSimulator(testFleet(), simulationStart)
+	assert.Nil(t, err)
+	out := &bytes.Buffer{}
+	assert.Nil(t, simulator.Run(time.Second, NewJSONLinesWriter(out).Write))
+	assert.Contains(t, out.String(), `"mac":"F1:A2:B3:C4:D5:E6"`)
+}
@@ -1,6 +1,10 @@
 package kontaktparser
 
-import "github.com/google/uuid"
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
 
 // IBeaconAdvertisement is a structure holding data from iBeacon advertisement
 type IBeaconAdvertisement struct {
@@ -91,6 +95,32 @@ const (
 	MovementInfo TelemetryPID = 0x16
 )
 
+var telemetryPIDNames = map[TelemetryPID]string{
+	SystemHealth:     "system_health",
+	Accelerometer:    "accelerometer",
+	Sensors:          "sensors",
+	Acceleration:     "acceleration",
+	Movement:         "movement",
+	DoubleTap:        "double_tap",
+	LightLevel:       "light_level",
+	Temperature8Bit:  "temperature_8bit",
+	Temperature16Bit: "temperature_16bit",
+	BatteryLevel:     "battery_level",
+	Click:            "click",
+	ClickInfo:        "click_info",
+	UTCTime:          "utc_time",
+	Humidity:         "humidity",
+	MovementInfo:     "movement_info",
+}
+
+// String returns snake_case name of the field, PIDs not known by parser are returned in 0x00 form
+func (pid TelemetryPID) String() string {
+	if name, ok := telemetryPIDNames[pid]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%02x", uint8(pid))
+}
+
 // KontaktTelemetryValue is a container for storing single value of telemetry data
 type KontaktTelemetryValue struct {
 	PID   TelemetryPID
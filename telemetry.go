@@ -6,13 +6,91 @@ import (
 )
 
 var (
-	ErrInvalidTelemetryPID = errors.New("invalid telemetry field pid")
+	ErrInvalidTelemetryPID     = errors.New("invalid telemetry field pid")
+	ErrUnsupportedTelemetryPID = errors.New("telemetry field not supported yet")
 )
 
 type FieldParser interface {
 	Parse(value KontaktTelemetryValue) error
 }
 
+// FieldValuer is implemented by field parsers which report named values of parsed field, all parsers
+// returned by NewFieldParser implement it. Integers are reported as int64 and fractions as float64,
+// fields of different PIDs use the same name for the same quantity, e.g. "temperature".
+type FieldValuer interface {
+	Values() map[string]interface{}
+}
+
+// NewFieldParser returns empty parser of telemetry field with given PID, false is returned for PIDs
+// not supported by parser
+func NewFieldParser(pid TelemetryPID) (FieldParser, bool) {
+	switch pid {
+	case SystemHealth:
+		return &SystemHealthFieldParser{}, true
+	case Accelerometer:
+		return &AccelerometerFieldParser{}, true
+	case Sensors:
+		return &SensorsFieldParser{}, true
+	case Acceleration:
+		return &AccelerationFieldParser{}, true
+	case Movement:
+		return &MovementFieldParser{}, true
+	case DoubleTap:
+		return &DoubleTapFieldParser{}, true
+	case LightLevel:
+		return &LightLevelFieldParser{}, true
+	case Temperature8Bit:
+		return &Temperature8BitFieldParser{}, true
+	case Temperature16Bit:
+		return &Temperature16BitFieldParser{}, true
+	case BatteryLevel:
+		return &BatteryFieldParser{}, true
+	case Click:
+		return &ClickFieldParser{}, true
+	case ClickInfo:
+		return &ClickInfoFieldParser{}, true
+	case UTCTime:
+		return &UTCTimeFieldParser{}, true
+	case Humidity:
+		return &HumidityFieldParser{}, true
+	case MovementInfo:
+		return &MovementInfoFieldParser{}, true
+	}
+	return nil, false
+}
+
+// DecodeTelemetry parses telemetry field with parser matching its PID
+func DecodeTelemetry(value KontaktTelemetryValue) (FieldParser, error) {
+	parser, ok := NewFieldParser(value.PID)
+	if !ok {
+		return nil, ErrUnsupportedTelemetryPID
+	}
+	if err := parser.Parse(value); err != nil {
+		return nil, err
+	}
+	return parser, nil
+}
+
+// DecodeTelemetryValues returns named values (see FieldValuer) of all telemetry fields which could be
+// decoded. When several fields report the same value, the last one is kept.
+func DecodeTelemetryValues(frame *KontaktTelemetryAdvertisement) map[string]interface{} {
+	values := make(map[string]interface{})
+	for _, field := range frame.Fields {
+		decoded, err := DecodeTelemetry(field)
+		if err != nil {
+			continue
+		}
+		valuer, ok := decoded.(FieldValuer)
+		if !ok {
+			continue
+		}
+		for name, value := range valuer.Values() {
+			values[name] = value
+		}
+	}
+	return values
+}
+
 func assertions(value KontaktTelemetryValue, pid TelemetryPID, length int) error {
 	if value.PID != pid || len(value.Value) != length {
 		return ErrInvalidTelemetryPID
@@ -34,6 +112,10 @@ func (p *SystemHealthFieldParser) Parse(value KontaktTelemetryValue) error {
 	return nil
 }
 
+func (p *SystemHealthFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{"utc_time": int64(p.UnixTimestamp), "battery_level": int64(p.BatteryLevel)}
+}
+
 type AccelerometerFieldParser struct {
 	Sensitivity           uint8
 	X                     int8
@@ -56,6 +138,17 @@ func (p *AccelerometerFieldParser) Parse(value KontaktTelemetryValue) error {
 	return nil
 }
 
+func (p *AccelerometerFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{
+		"sensitivity":              int64(p.Sensitivity),
+		"acceleration_x":           int64(p.X),
+		"acceleration_y":           int64(p.Y),
+		"acceleration_z":           int64(p.Z),
+		"seconds_since_double_tap": int64(p.SecondsSinceDoubleTap),
+		"seconds_since_threshold":  int64(p.SecondsSinceThreshold),
+	}
+}
+
 type SensorsFieldParser struct {
 	LightLevel  uint8
 	Temperature int8
@@ -70,6 +163,10 @@ func (p *SensorsFieldParser) Parse(value KontaktTelemetryValue) error {
 	return nil
 }
 
+func (p *SensorsFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{"light_level": int64(p.LightLevel), "temperature": float64(p.Temperature)}
+}
+
 type AccelerationFieldParser struct {
 	Sensitivity uint8
 	X           int8
@@ -88,6 +185,15 @@ func (p *AccelerationFieldParser) Parse(value KontaktTelemetryValue) error {
 	return nil
 }
 
+func (p *AccelerationFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{
+		"sensitivity":    int64(p.Sensitivity),
+		"acceleration_x": int64(p.X),
+		"acceleration_y": int64(p.Y),
+		"acceleration_z": int64(p.Z),
+	}
+}
+
 type MovementFieldParser struct {
 	SecondsSinceThreshold uint16
 }
@@ -100,6 +206,10 @@ func (p *MovementFieldParser) Parse(value KontaktTelemetryValue) error {
 	return nil
 }
 
+func (p *MovementFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{"seconds_since_threshold": int64(p.SecondsSinceThreshold)}
+}
+
 type DoubleTapFieldParser struct {
 	SecondsSinceDoubleTap uint16
 }
@@ -112,6 +222,10 @@ func (p *DoubleTapFieldParser) Parse(value KontaktTelemetryValue) error {
 	return nil
 }
 
+func (p *DoubleTapFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{"seconds_since_double_tap": int64(p.SecondsSinceDoubleTap)}
+}
+
 type LightLevelFieldParser struct {
 	LightLevel uint8
 }
@@ -124,6 +238,10 @@ func (p *LightLevelFieldParser) Parse(value KontaktTelemetryValue) error {
 	return nil
 }
 
+func (p *LightLevelFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{"light_level": int64(p.LightLevel)}
+}
+
 type Temperature8BitFieldParser struct {
 	Temperature int8
 }
@@ -136,6 +254,10 @@ func (p *Temperature8BitFieldParser) Parse(value KontaktTelemetryValue) error {
 	return nil
 }
 
+func (p *Temperature8BitFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{"temperature": float64(p.Temperature)}
+}
+
 type Temperature16BitFieldParser struct {
 	Temperature float32
 }
@@ -149,6 +271,10 @@ func (p *Temperature16BitFieldParser) Parse(value KontaktTelemetryValue) error {
 	return nil
 }
 
+func (p *Temperature16BitFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{"temperature": float64(p.Temperature)}
+}
+
 type BatteryFieldParser struct {
 	BatteryLevel uint8
 }
@@ -161,6 +287,10 @@ func (p *BatteryFieldParser) Parse(value KontaktTelemetryValue) error {
 	return nil
 }
 
+func (p *BatteryFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{"battery_level": int64(p.BatteryLevel)}
+}
+
 type ClickFieldParser struct {
 	SecondsSinceClick uint16
 }
@@ -173,6 +303,10 @@ func (p *ClickFieldParser) Parse(value KontaktTelemetryValue) error {
 	return nil
 }
 
+func (p *ClickFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{"seconds_since_click": int64(p.SecondsSinceClick)}
+}
+
 type ClickInfoFieldParser struct {
 	ClickID           uint8
 	SecondsSinceClick uint16
@@ -187,6 +321,10 @@ func (p *ClickInfoFieldParser) Parse(value KontaktTelemetryValue) error {
 	return nil
 }
 
+func (p *ClickInfoFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{"click_id": int64(p.ClickID), "seconds_since_click": int64(p.SecondsSinceClick)}
+}
+
 type UTCTimeFieldParser struct {
 	UTCTime uint32
 }
@@ -199,6 +337,10 @@ func (p *UTCTimeFieldParser) Parse(value KontaktTelemetryValue) error {
 	return nil
 }
 
+func (p *UTCTimeFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{"utc_time": int64(p.UTCTime)}
+}
+
 type HumidityFieldParser struct {
 	Humidity uint8
 }
@@ -211,6 +353,10 @@ func (p *HumidityFieldParser) Parse(value KontaktTelemetryValue) error {
 	return nil
 }
 
+func (p *HumidityFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{"humidity": int64(p.Humidity)}
+}
+
 type MovementInfoFieldParser struct {
 	Counter               uint8
 	SecondsSinceThreshold uint16
@@ -224,3 +370,7 @@ func (p *MovementInfoFieldParser) Parse(value KontaktTelemetryValue) error {
 	p.SecondsSinceThreshold = binary.LittleEndian.Uint16(value.Value[1:])
 	return nil
 }
+
+func (p *MovementInfoFieldParser) Values() map[string]interface{} {
+	return map[string]interface{}{"movement_counter": int64(p.Counter), "seconds_since_threshold": int64(p.SecondsSinceThreshold)}
+}
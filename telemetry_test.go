@@ -239,3 +239,72 @@ func TestMovementInfoWrongPID(t *testing.T) {
 	field := MovementInfoFieldParser{}
 	assert.Equal(t, ErrInvalidTelemetryPID, field.Parse(tlm))
 }
+
+func TestDecodeTelemetry(t *testing.T) {
+	decoded, err := DecodeTelemetry(buildField(t, SystemHealth, "002F685964"))
+	assert.Nil(t, err)
+	assert.Equal(t, &SystemHealthFieldParser{UnixTimestamp: 1500000000, BatteryLevel: 100}, decoded)
+
+	decoded, err = DecodeTelemetry(buildField(t, Humidity, "2A"))
+	assert.Nil(t, err)
+	assert.Equal(t, &HumidityFieldParser{Humidity: 42}, decoded)
+
+	_, err = DecodeTelemetry(buildField(t, Humidity, "2A2A"))
+	assert.Equal(t, ErrInvalidTelemetryPID, err)
+
+	_, err = DecodeTelemetry(buildField(t, TelemetryPID(0x30), "00"))
+	assert.Equal(t, ErrUnsupportedTelemetryPID, err)
+}
+
+// validFields returns field with valid length for every PID supported by NewFieldParser
+func validFields() []KontaktTelemetryValue {
+	fields := make([]KontaktTelemetryValue, 0)
+	for pid := 0; pid < 256; pid++ {
+		parser, ok := NewFieldParser(TelemetryPID(pid))
+		if !ok {
+			continue
+		}
+		for length := 0; length <= 16; length++ {
+			field := KontaktTelemetryValue{PID: TelemetryPID(pid), Value: make([]byte, length)}
+			if parser.Parse(field) == nil {
+				fields = append(fields, field)
+				break
+			}
+		}
+	}
+	return fields
+}
+
+func TestFieldValues(t *testing.T) {
+	fields := validFields()
+	assert.Equal(t, 15, len(fields))
+	for _, field := range fields {
+		decoded, err := DecodeTelemetry(field)
+		assert.Nil(t, err)
+		valuer, ok := decoded.(FieldValuer)
+		assert.True(t, ok, field.PID.String())
+		assert.NotEmpty(t, valuer.Values(), field.PID.String())
+		for name, value := range valuer.Values() {
+			switch value.(type) {
+			case int64, float64:
+			default:
+				assert.Fail(t, "unexpected value type", name)
+			}
+		}
+	}
+}
+
+func TestDecodeTelemetryValues(t *testing.T) {
+	values := DecodeTelemetryValues(&KontaktTelemetryAdvertisement{Fields: []KontaktTelemetryValue{
+		buildField(t, Sensors, "4B14"),
+		buildField(t, Temperature16Bit, "1A80"),
+		buildField(t, Humidity, "2A2A"),
+		buildField(t, MovementInfo, "050A00"),
+	}})
+	assert.Equal(t, map[string]interface{}{
+		"light_level":             int64(75),
+		"temperature":             26.5,
+		"movement_counter":        int64(5),
+		"seconds_since_threshold": int64(10),
+	}, values)
+}
@@ -0,0 +1,317 @@
+package kontaktparser
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// identityRank orders identity kinds, higher is better
+const (
+	macIdentity = iota
+	eddystoneIdentity
+	ibeaconIdentity
+	kontaktIdentity
+)
+
+// FrameIdentity returns identity of a beacon carried by parsed frame (Parser.Parsed).
+// Kontakt.io Unique ID is returned as "kontakt:<id>", iBeacon as "ibeacon:<uuid>:<major>:<minor>" and
+// Eddystone UID as "eddystone:<namespace hex>:<instance hex>". False is returned for frames without identity,
+// including Kontakt.io shuffled frames, which namespace and instance rotate.
+func FrameIdentity(parsed interface{}) (string, bool) {
+	id, _, ok := frameIdentity(parsed)
+	return id, ok
+}
+
+func frameIdentity(parsed interface{}) (string, int, bool) {
+	switch frame := parsed.(type) {
+	case *KontaktPlainAdvertisement:
+		return kontaktID(frame.UniqueID), kontaktIdentity, true
+	case *KontaktLocationAdvertisement:
+		return kontaktID(frame.UniqueID), kontaktIdentity, true
+	case *KontaktIOScanResponse:
+		if frame.HasIdentifier {
+			return kontaktID(frame.UniqueID), kontaktIdentity, true
+		}
+	case *IBeaconAdvertisement:
+		return fmt.Sprintf("ibeacon:%v:%v:%v", frame.ProximityUUID, frame.Major, frame.Minor), ibeaconIdentity, true
+	case *EddystoneUIDPacket:
+		return eddystoneID(frame.Namespace, frame.InstanceId), eddystoneIdentity, true
+	}
+	return "", macIdentity, false
+}
+
+func kontaktID(uniqueID string) string {
+	return "kontakt:" + uniqueID
+}
+
+func eddystoneID(namespace []byte, instance []byte) string {
+	return "eddystone:" + hex.EncodeToString(namespace) + ":" + hex.EncodeToString(instance)
+}
+
+func macID(mac string) string {
+	return "mac:" + strings.ToUpper(mac)
+}
+
+// Device is a state of a single beacon kept by Tracker
+type Device struct {
+	// ID is the best identity known for the device, see FrameIdentity. Devices that sent only frames
+	// without identity are keyed by "mac:<MAC>".
+	ID        string
+	MAC       string
+	FirstSeen time.Time
+	LastSeen  time.Time
+	LastRSSI  int8
+
+	IBeacon       *IBeaconAdvertisement
+	ScanResponse  *KontaktIOScanResponse
+	Plain         *KontaktPlainAdvertisement
+	Shuffled      *KontaktShuffledAdvertisement
+	Telemetry     *KontaktTelemetryAdvertisement
+	Location      *KontaktLocationAdvertisement
+	EddystoneUID  *EddystoneUIDPacket
+	EddystoneURL  *EddystoneURLPacket
+	EddystoneTLM  *EddystonePlainTLMPacket
+	EddystoneETLM *EddystoneEncryptedTLMPacket
+	EddystoneEID  *EddystoneEIDPacket
+
+	rank int
+}
+
+func (d *Device) update(parsed interface{}) {
+	switch frame := parsed.(type) {
+	case *IBeaconAdvertisement:
+		d.IBeacon = frame
+	case *KontaktIOScanResponse:
+		d.ScanResponse = frame
+	case *KontaktPlainAdvertisement:
+		d.Plain = frame
+	case *KontaktShuffledAdvertisement:
+		d.Shuffled = frame
+	case *KontaktTelemetryAdvertisement:
+		d.Telemetry = frame
+	case *KontaktLocationAdvertisement:
+		d.Location = frame
+	case *EddystoneUIDPacket:
+		d.EddystoneUID = frame
+	case *EddystoneURLPacket:
+		d.EddystoneURL = frame
+	case *EddystonePlainTLMPacket:
+		d.EddystoneTLM = frame
+	case *EddystoneEncryptedTLMPacket:
+		d.EddystoneETLM = frame
+	case *EddystoneEIDPacket:
+		d.EddystoneEID = frame
+	}
+}
+
+// merge copies into d frames known by other device and not yet received by d
+func (d *Device) merge(other *Device) {
+	if d.IBeacon == nil {
+		d.IBeacon = other.IBeacon
+	}
+	if d.ScanResponse == nil {
+		d.ScanResponse = other.ScanResponse
+	}
+	if d.Plain == nil {
+		d.Plain = other.Plain
+	}
+	if d.Shuffled == nil {
+		d.Shuffled = other.Shuffled
+	}
+	if d.Telemetry == nil {
+		d.Telemetry = other.Telemetry
+	}
+	if d.Location == nil {
+		d.Location = other.Location
+	}
+	if d.EddystoneUID == nil {
+		d.EddystoneUID = other.EddystoneUID
+	}
+	if d.EddystoneURL == nil {
+		d.EddystoneURL = other.EddystoneURL
+	}
+	if d.EddystoneTLM == nil {
+		d.EddystoneTLM = other.EddystoneTLM
+	}
+	if d.EddystoneETLM == nil {
+		d.EddystoneETLM = other.EddystoneETLM
+	}
+	if d.EddystoneEID == nil {
+		d.EddystoneEID = other.EddystoneEID
+	}
+	if other.FirstSeen.Before(d.FirstSeen) {
+		d.FirstSeen = other.FirstSeen
+	}
+}
+
+// Model returns device model sent in any Kontakt.io plain, shuffled or location frame received from the device
+func (d Device) Model() DeviceModel {
+	switch {
+	case d.Plain != nil:
+		return d.Plain.Model()
+	case d.Shuffled != nil:
+		return d.Shuffled.Model()
+	case d.Location != nil:
+		return d.Location.Model()
+	}
+	return UnknownModel
+}
+
+// Tracker keeps a live table of beacons built from parsed frames. Frames are keyed by the best identity
+// they carry, frames without identity (e.g. telemetry) are linked to device by MAC address.
+// Tracker is safe for concurrent use.
+type Tracker struct {
+	// OnEnter is called when a new device is seen. It has to be set before Tracker is used.
+	OnEnter func(Device)
+	// OnExit is called when device expires or is merged into another one. It has to be set before
+	// Tracker is used. Device which gets better identity exits under the old ID and enters under the new one.
+	OnExit func(Device)
+
+	timeout time.Duration
+	mutex   sync.Mutex
+	devices map[string]*Device
+	macs    map[string]string
+}
+
+// NewTracker creates Tracker which expires devices not seen for longer than timeout
+func NewTracker(timeout time.Duration) *Tracker {
+	return &Tracker{
+		timeout: timeout,
+		devices: make(map[string]*Device),
+		macs:    make(map[string]string),
+	}
+}
+
+// Ingest updates device state with frame received from given MAC address and returns updated device.
+// Parsed should be Parser.Parsed value. Frames with empty MAC address are linked to devices by their
+// identity only, frames with neither MAC nor identity aren't tracked and are returned as device with empty ID.
+func (t *Tracker) Ingest(mac string, rssi int8, received time.Time, parsed interface{}) Device {
+	mac = strings.ToUpper(mac)
+	t.mutex.Lock()
+	device, created, removed := t.ingest(mac, rssi, received, parsed)
+	snapshot := *device
+	t.mutex.Unlock()
+
+	if removed != nil && t.OnExit != nil {
+		t.OnExit(*removed)
+	}
+	if created && t.OnEnter != nil {
+		t.OnEnter(snapshot)
+	}
+	return snapshot
+}
+
+// ingest updates device with frame, it reports whether the device is new and returns device which was
+// replaced by it, if any
+func (t *Tracker) ingest(mac string, rssi int8, received time.Time, parsed interface{}) (*Device, bool, *Device) {
+	id, rank, ok := frameIdentity(parsed)
+	if !ok && mac == "" {
+		device := &Device{FirstSeen: received, LastSeen: received, LastRSSI: rssi}
+		device.update(parsed)
+		return device, false, nil
+	}
+	if !ok {
+		id = macID(mac)
+	}
+
+	var linked *Device
+	if mac != "" {
+		linked = t.devices[t.macs[mac]]
+	}
+	device := t.devices[id]
+	created := false
+	var removed *Device
+	switch {
+	case linked != nil && (!ok || linked.ID == id || linked.rank > rank):
+		device = linked
+	case linked != nil && linked.rank < rank:
+		// frame carries better identity than the one device is known by
+		delete(t.devices, linked.ID)
+		old := *linked
+		removed = &old
+		if device == nil {
+			device = linked
+			device.ID = id
+			device.rank = rank
+			t.devices[id] = device
+			created = true
+		} else {
+			device.merge(linked)
+		}
+	case device == nil:
+		device = &Device{ID: id, FirstSeen: received, rank: rank}
+		t.devices[id] = device
+		created = true
+	}
+
+	if mac != "" {
+		t.macs[mac] = device.ID
+		device.MAC = mac
+	}
+	device.LastRSSI = rssi
+	if received.After(device.LastSeen) {
+		device.LastSeen = received
+	}
+	device.update(parsed)
+	return device, created, removed
+}
+
+// Expire removes devices not seen for longer than timeout, calling OnExit for each of them.
+// It should be called periodically.
+func (t *Tracker) Expire(now time.Time) []Device {
+	expired := make([]Device, 0)
+	t.mutex.Lock()
+	for id, device := range t.devices {
+		if now.Sub(device.LastSeen) > t.timeout {
+			delete(t.devices, id)
+			expired = append(expired, *device)
+		}
+	}
+	for mac, id := range t.macs {
+		if _, ok := t.devices[id]; !ok {
+			delete(t.macs, mac)
+		}
+	}
+	t.mutex.Unlock()
+
+	if t.OnExit != nil {
+		for _, device := range expired {
+			t.OnExit(device)
+		}
+	}
+	return expired
+}
+
+// Device returns device with given ID
+func (t *Tracker) Device(id string) (Device, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if device, ok := t.devices[id]; ok {
+		return *device, true
+	}
+	return Device{}, false
+}
+
+// DeviceByMAC returns device which recently sent frame from given MAC address
+func (t *Tracker) DeviceByMAC(mac string) (Device, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if device, ok := t.devices[t.macs[strings.ToUpper(mac)]]; ok {
+		return *device, true
+	}
+	return Device{}, false
+}
+
+// Devices returns snapshot of all tracked devices
+func (t *Tracker) Devices() []Device {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	devices := make([]Device, 0, len(t.devices))
+	for _, device := range t.devices {
+		devices = append(devices, *device)
+	}
+	return devices
+}
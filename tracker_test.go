@@ -0,0 +1,225 @@
+package kontaktparser
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var trackerStart = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func TestFrameIdentity(t *testing.T) {
+	id, ok := FrameIdentity(&KontaktPlainAdvertisement{UniqueID: "abcd"})
+	assert.True(t, ok)
+	assert.Equal(t, "kontakt:abcd", id)
+
+	id, ok = FrameIdentity(&IBeaconAdvertisement{
+		ProximityUUID: uuid.MustParse("F7826DA6-4FA2-4E98-8024-BC5B71E0893E"),
+		Major:         1,
+		Minor:         2,
+	})
+	assert.True(t, ok)
+	assert.Equal(t, "ibeacon:f7826da6-4fa2-4e98-8024-bc5b71e0893e:1:2", id)
+
+	id, ok = FrameIdentity(&EddystoneUIDPacket{Namespace: []byte{0x01, 0x02}, InstanceId: []byte{0xAB}})
+	assert.True(t, ok)
+	assert.Equal(t, "eddystone:0102:ab", id)
+
+	_, ok = FrameIdentity(&KontaktTelemetryAdvertisement{})
+	assert.False(t, ok)
+	_, ok = FrameIdentity(&KontaktIOScanResponse{HasName: true})
+	assert.False(t, ok)
+	_, ok = FrameIdentity(&KontaktShuffledAdvertisement{EddystoneNamespace: []byte{0x01}, EddystoneInstanceID: []byte{0x02}})
+	assert.False(t, ok)
+}
+
+func TestTrackerIngest(t *testing.T) {
+	tracker := NewTracker(time.Minute)
+	entered := make([]Device, 0)
+	tracker.OnEnter = func(device Device) {
+		entered = append(entered, device)
+	}
+
+	plain := &KontaktPlainAdvertisement{UniqueID: "abcd", BatteryLevel: 90}
+	telemetry := &KontaktTelemetryAdvertisement{}
+	tracker.Ingest("aa:bb:cc:dd:ee:ff", -60, trackerStart, plain)
+	device := tracker.Ingest("AA:BB:CC:DD:EE:FF", -70, trackerStart.Add(time.Second), telemetry)
+
+	assert.Equal(t, "kontakt:abcd", device.ID)
+	assert.Equal(t, "AA:BB:CC:DD:EE:FF", device.MAC)
+	assert.Equal(t, int8(-70), device.LastRSSI)
+	assert.Equal(t, trackerStart, device.FirstSeen)
+	assert.Equal(t, trackerStart.Add(time.Second), device.LastSeen)
+	assert.Equal(t, plain, device.Plain)
+	assert.Equal(t, telemetry, device.Telemetry)
+	assert.Equal(t, 1, len(entered))
+	assert.Equal(t, 1, len(tracker.Devices()))
+}
+
+func TestTrackerPromotesIdentity(t *testing.T) {
+	tracker := NewTracker(time.Minute)
+	entered := make([]string, 0)
+	tracker.OnEnter = func(device Device) {
+		entered = append(entered, device.ID)
+	}
+	exited := make([]string, 0)
+	tracker.OnExit = func(device Device) {
+		exited = append(exited, device.ID)
+	}
+
+	tlm := &EddystonePlainTLMPacket{BatteryVoltage: 3000}
+	device := tracker.Ingest("AA:BB:CC:DD:EE:FF", -60, trackerStart, tlm)
+	assert.Equal(t, "mac:AA:BB:CC:DD:EE:FF", device.ID)
+
+	uid := &EddystoneUIDPacket{Namespace: []byte{0x01}, InstanceId: []byte{0x02}}
+	device = tracker.Ingest("AA:BB:CC:DD:EE:FF", -60, trackerStart.Add(time.Second), uid)
+	assert.Equal(t, "eddystone:01:02", device.ID)
+	assert.Equal(t, tlm, device.EddystoneTLM)
+
+	location := &KontaktLocationAdvertisement{UniqueID: "abcd"}
+	device = tracker.Ingest("AA:BB:CC:DD:EE:FF", -60, trackerStart.Add(2*time.Second), location)
+	assert.Equal(t, "kontakt:abcd", device.ID)
+	assert.Equal(t, uid, device.EddystoneUID)
+	assert.Equal(t, tlm, device.EddystoneTLM)
+
+	// identity of lower rank from the same MAC is attached to the device
+	ibeacon := &IBeaconAdvertisement{Major: 1, Minor: 2}
+	device = tracker.Ingest("AA:BB:CC:DD:EE:FF", -60, trackerStart.Add(3*time.Second), ibeacon)
+	assert.Equal(t, "kontakt:abcd", device.ID)
+	assert.Equal(t, ibeacon, device.IBeacon)
+
+	// device exits under each identity it's replaced
+	assert.Equal(t, []string{"mac:AA:BB:CC:DD:EE:FF", "eddystone:01:02", "kontakt:abcd"}, entered)
+	assert.Equal(t, []string{"mac:AA:BB:CC:DD:EE:FF", "eddystone:01:02"}, exited)
+	assert.Equal(t, 1, len(tracker.Devices()))
+	_, ok := tracker.Device("mac:AA:BB:CC:DD:EE:FF")
+	assert.False(t, ok)
+}
+
+func TestTrackerMergesWithKnownIdentity(t *testing.T) {
+	tracker := NewTracker(time.Minute)
+	exited := make([]Device, 0)
+	tracker.OnExit = func(device Device) {
+		exited = append(exited, device)
+	}
+	plain := &KontaktPlainAdvertisement{UniqueID: "abcd"}
+	tracker.Ingest("11:11:11:11:11:11", -60, trackerStart, plain)
+
+	// beacon rotated its MAC address
+	telemetry := &KontaktTelemetryAdvertisement{}
+	tracker.Ingest("22:22:22:22:22:22", -60, trackerStart.Add(time.Second), telemetry)
+	assert.Equal(t, 2, len(tracker.Devices()))
+
+	device := tracker.Ingest("22:22:22:22:22:22", -60, trackerStart.Add(2*time.Second), plain)
+	assert.Equal(t, "kontakt:abcd", device.ID)
+	assert.Equal(t, telemetry, device.Telemetry)
+	assert.Equal(t, 1, len(tracker.Devices()))
+	assert.Equal(t, 1, len(exited))
+	assert.Equal(t, "mac:22:22:22:22:22:22", exited[0].ID)
+
+	device, ok := tracker.DeviceByMAC("22:22:22:22:22:22")
+	assert.True(t, ok)
+	assert.Equal(t, "kontakt:abcd", device.ID)
+}
+
+func TestTrackerLinksShuffledFramesByMAC(t *testing.T) {
+	tracker := NewTracker(time.Minute)
+	entered := 0
+	tracker.OnEnter = func(device Device) {
+		entered++
+	}
+	for i := byte(0); i < 3; i++ {
+		// identifiers rotate, MAC address stays
+		shuffled := &KontaktShuffledAdvertisement{EddystoneNamespace: []byte{i}, EddystoneInstanceID: []byte{i}}
+		device := tracker.Ingest("AA:BB:CC:DD:EE:FF", -60, trackerStart, shuffled)
+		assert.Equal(t, "mac:AA:BB:CC:DD:EE:FF", device.ID)
+		assert.Equal(t, shuffled, device.Shuffled)
+	}
+	assert.Equal(t, 1, entered)
+	assert.Equal(t, 1, len(tracker.Devices()))
+}
+
+func TestTrackerSeparatesDevicesSharingMAC(t *testing.T) {
+	tracker := NewTracker(time.Minute)
+	tracker.Ingest("AA:BB:CC:DD:EE:FF", -60, trackerStart, &KontaktPlainAdvertisement{UniqueID: "abcd"})
+	device := tracker.Ingest("AA:BB:CC:DD:EE:FF", -60, trackerStart, &KontaktPlainAdvertisement{UniqueID: "efgh"})
+
+	assert.Equal(t, "kontakt:efgh", device.ID)
+	assert.Equal(t, 2, len(tracker.Devices()))
+}
+
+func TestTrackerWithoutMAC(t *testing.T) {
+	tracker := NewTracker(time.Minute)
+	plain := &KontaktPlainAdvertisement{UniqueID: "AAAA"}
+	ibeacon := &IBeaconAdvertisement{ProximityUUID: uuid.MustParse("F7826DA6-4FA2-4E98-8024-BC5B71E0893E")}
+	assert.Equal(t, "kontakt:AAAA", tracker.Ingest("", -60, trackerStart, plain).ID)
+	assert.Equal(t, "ibeacon:f7826da6-4fa2-4e98-8024-bc5b71e0893e:0:0", tracker.Ingest("", -60, trackerStart, ibeacon).ID)
+
+	telemetry := &KontaktTelemetryAdvertisement{}
+	device := tracker.Ingest("", -70, trackerStart, telemetry)
+	assert.Equal(t, "", device.ID)
+	assert.Equal(t, telemetry, device.Telemetry)
+	assert.Equal(t, int8(-70), device.LastRSSI)
+
+	device, ok := tracker.Device("kontakt:AAAA")
+	assert.True(t, ok)
+	assert.Nil(t, device.IBeacon)
+	assert.Nil(t, device.Telemetry)
+	assert.Equal(t, 2, len(tracker.Devices()))
+	_, ok = tracker.DeviceByMAC("")
+	assert.False(t, ok)
+}
+
+func TestTrackerKeepsMACOfFramesWithoutMAC(t *testing.T) {
+	tracker := NewTracker(time.Minute)
+	tracker.Ingest("AA:BB:CC:DD:EE:FF", -60, trackerStart, &KontaktPlainAdvertisement{UniqueID: "abcd"})
+	device := tracker.Ingest("", -60, trackerStart, &KontaktPlainAdvertisement{UniqueID: "abcd"})
+	assert.Equal(t, "AA:BB:CC:DD:EE:FF", device.MAC)
+}
+
+func TestDeviceModel(t *testing.T) {
+	assert.Equal(t, UnknownModel, Device{Telemetry: &KontaktTelemetryAdvertisement{}}.Model())
+	assert.Equal(t, SmartBeacon3, Device{Plain: &KontaktPlainAdvertisement{DeviceModel: 0x06}}.Model())
+	assert.Equal(t, AssetTag, Device{Location: &KontaktLocationAdvertisement{DeviceModel: 0x08}}.Model())
+}
+
+func TestTrackerExpire(t *testing.T) {
+	tracker := NewTracker(time.Minute)
+	exited := make([]string, 0)
+	tracker.OnExit = func(device Device) {
+		exited = append(exited, device.ID)
+	}
+
+	tracker.Ingest("11:11:11:11:11:11", -60, trackerStart, &KontaktPlainAdvertisement{UniqueID: "abcd"})
+	tracker.Ingest("22:22:22:22:22:22", -60, trackerStart.Add(time.Minute), &KontaktPlainAdvertisement{UniqueID: "efgh"})
+
+	assert.Equal(t, 0, len(tracker.Expire(trackerStart.Add(time.Minute))))
+	expired := tracker.Expire(trackerStart.Add(90 * time.Second))
+	assert.Equal(t, 1, len(expired))
+	assert.Equal(t, []string{"kontakt:abcd"}, exited)
+
+	_, ok := tracker.DeviceByMAC("11:11:11:11:11:11")
+	assert.False(t, ok)
+	_, ok = tracker.Device("kontakt:efgh")
+	assert.True(t, ok)
+}
+
+func TestTrackerConcurrentIngest(t *testing.T) {
+	tracker := NewTracker(time.Minute)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				tracker.Ingest("AA:BB:CC:DD:EE:FF", -60, trackerStart, &KontaktPlainAdvertisement{UniqueID: "abcd"})
+				tracker.Devices()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, len(tracker.Devices()))
+}